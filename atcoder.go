@@ -0,0 +1,108 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AtCoder Profile: ^https://atcoder\.jp/users/[A-Za-z0-9_]{3,16}/?$
+// AtCoder Contest: ^https://atcoder\.jp/contests/[a-z0-9_-]{1,50}/?$
+// AtCoder Task: ^https://atcoder\.jp/contests/[a-z0-9_-]{1,50}/tasks/[a-z0-9_-]{1,50}/?$
+
+func decodeAtCoderURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid AtCoder scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "atcoder.jp" && url.Host != "www.atcoder.jp" {
+		return nil, fmt.Errorf("%w: invalid AtCoder host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid AtCoder path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] == "users":
+		username := parts[1]
+		if len(username) < 3 || len(username) > 16 {
+			return nil, fmt.Errorf("%w: invalid AtCoder username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotAtCoderUsernameRune) {
+			return nil, fmt.Errorf("%w: invalid AtCoder username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: AtCoder,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "contests":
+		contestID := parts[1]
+		if !isAtCoderIDValid(contestID) {
+			return nil, fmt.Errorf("%w: invalid AtCoder contest ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: AtCoder,
+			Type:    "Contest",
+			ID:      contestID,
+			Data: map[string]string{
+				"contestID": contestID,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 4 && parts[0] == "contests" && parts[2] == "tasks":
+		// Task IDs are unique across contests, as tasks shared by contests
+		// held together keep the ID they were given in one of them.
+		contestID, taskID := parts[1], parts[3]
+		if !isAtCoderIDValid(contestID) {
+			return nil, fmt.Errorf("%w: invalid AtCoder contest ID", ErrInvalidURL)
+		}
+		if !isAtCoderIDValid(taskID) {
+			return nil, fmt.Errorf("%w: invalid AtCoder task ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: AtCoder,
+			Type:    "Task",
+			ID:      taskID,
+			Data: map[string]string{
+				"contestID": contestID,
+				"taskID":    taskID,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid AtCoder path", ErrInvalidURL)
+	}
+}
+
+func isAtCoderIDValid(id string) bool {
+	return len(id) >= 1 && len(id) <= 50 && !strings.ContainsFunc(id, isNotAtCoderIDRune)
+}
+
+const atCoderUsernameAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotAtCoderUsernameRune(r rune) bool {
+	return !strings.ContainsRune(atCoderUsernameAlpha, r)
+}
+
+const atCoderIDAlpha = "abcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotAtCoderIDRune(r rune) bool {
+	return !strings.ContainsRune(atCoderIDAlpha, r)
+}
@@ -6,7 +6,11 @@ import (
 	"strings"
 )
 
-// Bandcamp Profile: ^https://[A-Za-z0-9-]+\.bandcamp\.com/?$
+// Bandcamp Artist: ^https://[A-Za-z0-9-]+\.bandcamp\.com(/music)?/?$
+// Bandcamp Album: ^https://[A-Za-z0-9-]+\.bandcamp\.com/album/[A-Za-z0-9-]{1,200}/?$
+// Bandcamp Track: ^https://[A-Za-z0-9-]+\.bandcamp\.com/track/[A-Za-z0-9-]{1,200}/?$
+// Bandcamp Merch: ^https://[A-Za-z0-9-]+\.bandcamp\.com/merch(/[A-Za-z0-9-]{1,200})?/?$
+// Bandcamp Fan: ^https://(www\.)?bandcamp\.com/[A-Za-z0-9_]{1,30}/?$
 
 func decodeBandcampURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,27 +20,130 @@ func decodeBandcampURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Bandcamp scheme", ErrInvalidURL)
 	}
 
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) > 0 && path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Bandcamp path", ErrInvalidURL)
+	}
+	var parts []string
+	if path != "" {
+		parts = strings.Split(strings.TrimPrefix(path, "/"), "/")
+	}
+
+	if url.Host == "bandcamp.com" || url.Host == "www.bandcamp.com" {
+		if len(parts) != 1 || bandcampReservedPaths[parts[0]] {
+			return nil, fmt.Errorf("%w: invalid Bandcamp path", ErrInvalidURL)
+		}
+
+		username := parts[0]
+		if len(username) < 1 || len(username) > 30 {
+			return nil, fmt.Errorf("%w: invalid Bandcamp username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotBandcampFanHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Bandcamp username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Bandcamp,
+			Type:    "Fan",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+	}
+
 	if !strings.HasSuffix(url.Host, ".bandcamp.com") {
 		return nil, fmt.Errorf("%w: invalid Bandcamp host", ErrInvalidURL)
 	}
 
-	username := strings.TrimSuffix(url.Host, ".bandcamp.com")
-	if len(username) < 1 || len(username) > 30 {
-		return nil, fmt.Errorf("%w: invalid Bandcamp username length", ErrInvalidURL)
+	artist := strings.TrimSuffix(url.Host, ".bandcamp.com")
+	if len(artist) < 1 || len(artist) > 30 {
+		return nil, fmt.Errorf("%w: invalid Bandcamp artist length", ErrInvalidURL)
 	}
-	if strings.ContainsFunc(username, isNotBandcampHandleRune) {
-		return nil, fmt.Errorf("%w: invalid Bandcamp username", ErrInvalidURL)
+	if strings.ContainsFunc(artist, isNotBandcampHandleRune) || bandcampReservedSubdomains[artist] {
+		return nil, fmt.Errorf("%w: invalid Bandcamp artist", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: Bandcamp,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch {
+	case len(parts) == 0 || (len(parts) == 1 && parts[0] == "music"):
+		return &URL{
+			Service: Bandcamp,
+			Type:    "Artist",
+			ID:      artist,
+			Data: map[string]string{
+				"artist": artist,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 1 && parts[0] == "merch":
+		return &URL{
+			Service: Bandcamp,
+			Type:    "Merch",
+			ID:      artist,
+			Data: map[string]string{
+				"artist": artist,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && (parts[0] == "album" || parts[0] == "track" || parts[0] == "merch"):
+		kind, slug := parts[0], parts[1]
+		if len(slug) < 1 || len(slug) > 200 {
+			return nil, fmt.Errorf("%w: invalid Bandcamp %s slug length", ErrInvalidURL, kind)
+		}
+		if strings.ContainsFunc(slug, isNotBandcampHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Bandcamp %s slug", ErrInvalidURL, kind)
+		}
+
+		return &URL{
+			Service: Bandcamp,
+			Type:    strings.ToUpper(kind[:1]) + kind[1:],
+			ID:      artist + "/" + slug,
+			Data: map[string]string{
+				"artist": artist,
+				kind:     slug,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Bandcamp path", ErrInvalidURL)
+	}
+}
+
+// bandcampReservedPaths holds Bandcamp's own pages. Artists have subdomains of
+// their own, so only fans share the top level of bandcamp.com with these.
+var bandcampReservedPaths = map[string]bool{
+	"about":        true,
+	"api":          true,
+	"artists":      true,
+	"discover":     true,
+	"fans":         true,
+	"feed":         true,
+	"gift_cards":   true,
+	"guide":        true,
+	"help":         true,
+	"labels":       true,
+	"login":        true,
+	"privacy":      true,
+	"search":       true,
+	"settings":     true,
+	"signup":       true,
+	"tag":          true,
+	"terms_of_use": true,
+}
+
+// bandcampReservedSubdomains holds the subdomains of bandcamp.com that are run
+// by Bandcamp itself rather than by artists.
+var bandcampReservedSubdomains = map[string]bool{
+	"api":   true,
+	"blog":  true,
+	"daily": true,
+	"get":   true,
+	"help":  true,
+	"www":   true,
 }
 
 const bandcampHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
@@ -44,3 +151,9 @@ const bandcampHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxy
 func isNotBandcampHandleRune(r rune) bool {
 	return !strings.ContainsRune(bandcampHandleAlpha, r)
 }
+
+const bandcampFanHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotBandcampFanHandleRune(r rune) bool {
+	return !strings.ContainsRune(bandcampFanHandleAlpha, r)
+}
@@ -0,0 +1,116 @@
+package slinky
+
+import "strings"
+
+// bech32 implements the Bech32 encoding of BIP 173, without its 90 character
+// length limit, which Nostr entities with TLV data often exceed.
+
+const bech32Alpha = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// decodeBech32 decodes s, a Bech32 string, into its human-readable part and
+// data bytes. It returns false if s is malformed or its checksum is wrong.
+func decodeBech32(s string) (string, []byte, bool) {
+	if s != strings.ToLower(s) && s != strings.ToUpper(s) {
+		return "", nil, false
+	}
+	s = strings.ToLower(s)
+
+	i := strings.LastIndexByte(s, '1')
+	if i < 1 || len(s)-i-1 < 6 {
+		return "", nil, false
+	}
+	hrp := s[:i]
+	for _, r := range hrp {
+		if r < 33 || r > 126 {
+			return "", nil, false
+		}
+	}
+
+	values := make([]byte, 0, len(s)-i-1)
+	for _, r := range s[i+1:] {
+		v := strings.IndexRune(bech32Alpha, r)
+		if v < 0 {
+			return "", nil, false
+		}
+		values = append(values, byte(v))
+	}
+	if bech32Polymod(append(bech32ExpandHRP(hrp), values...)) != 1 {
+		return "", nil, false
+	}
+
+	data, ok := convertBits(values[:len(values)-6], 5, 8, false)
+	if !ok {
+		return "", nil, false
+	}
+	return hrp, data, true
+}
+
+// encodeBech32 encodes data as a Bech32 string with the human-readable part
+// hrp.
+func encodeBech32(hrp string, data []byte) string {
+	values, _ := convertBits(data, 8, 5, true)
+	polymod := bech32Polymod(append(append(bech32ExpandHRP(hrp), values...), 0, 0, 0, 0, 0, 0)) ^ 1
+
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range values {
+		b.WriteByte(bech32Alpha[v])
+	}
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Alpha[(polymod>>(5*(5-i)))&31])
+	}
+	return b.String()
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32ExpandHRP(hrp string) []byte {
+	values := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	return values
+}
+
+// convertBits regroups data from groups of fromBits bits into groups of toBits
+// bits. Without pad, leftover bits must be zero and fewer than fromBits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, bool) {
+	var acc uint32
+	var bits uint
+	maxv := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, false
+	}
+	return out, true
+}
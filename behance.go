@@ -7,6 +7,7 @@ import (
 )
 
 // Behance Profile: ^https://(www\.)?behance\.net/[A-Za-z0-9-]{3,50}/?$
+// Behance Project: ^https://(www\.)?behance\.net/gallery/[0-9]{1,20}(/[A-Za-z0-9_-]{1,200})?/?$
 
 func decodeBehanceURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -24,8 +25,49 @@ func decodeBehanceURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Behance path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	if parts[0] == "gallery" {
+		if len(parts) != 2 && len(parts) != 3 {
+			return nil, fmt.Errorf("%w: invalid Behance path", ErrInvalidURL)
+		}
+
+		projectID := parts[1]
+		if len(projectID) < 1 || len(projectID) > 20 {
+			return nil, fmt.Errorf("%w: invalid Behance project ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(projectID, isNotBehanceIDRune) {
+			return nil, fmt.Errorf("%w: invalid Behance project ID", ErrInvalidURL)
+		}
+
+		data := map[string]string{
+			"projectID": projectID,
+		}
+		if len(parts) == 3 {
+			slug := parts[2]
+			if len(slug) < 1 || len(slug) > 200 {
+				return nil, fmt.Errorf("%w: invalid Behance project slug length", ErrInvalidURL)
+			}
+			if strings.ContainsFunc(slug, isNotBehanceSlugRune) {
+				return nil, fmt.Errorf("%w: invalid Behance project slug", ErrInvalidURL)
+			}
+			data["slug"] = slug
+		}
+
+		return &URL{
+			Service: Behance,
+			Type:    "Project",
+			ID:      projectID,
+			Data:    data,
+			URL:     url,
+		}, nil
+	}
+
+	if len(parts) != 1 || behanceReservedPaths[parts[0]] {
+		return nil, fmt.Errorf("%w: invalid Behance path", ErrInvalidURL)
+	}
+
+	username := parts[0]
 	if len(username) < 3 || len(username) > 50 {
 		return nil, fmt.Errorf("%w: invalid Behance username length", ErrInvalidURL)
 	}
@@ -44,8 +86,34 @@ func decodeBehanceURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+// behanceReservedPaths holds the site pages of behance.net, like /joblist and
+// /galleries, that would otherwise pass for profile names.
+var behanceReservedPaths = map[string]bool{
+	"about":      true,
+	"assets":     true,
+	"blog":       true,
+	"galleries":  true,
+	"hire":       true,
+	"joblist":    true,
+	"live":       true,
+	"onboarding": true,
+	"search":     true,
+}
+
 const behanceHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
 
 func isNotBehanceHandleRune(r rune) bool {
 	return !strings.ContainsRune(behanceHandleAlpha, r)
 }
+
+const behanceSlugAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotBehanceSlugRune(r rune) bool {
+	return !strings.ContainsRune(behanceSlugAlpha, r)
+}
+
+const behanceIDAlpha = "0123456789"
+
+func isNotBehanceIDRune(r rune) bool {
+	return !strings.ContainsRune(behanceIDAlpha, r)
+}
@@ -1,51 +1,290 @@
 package slinky
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 )
 
-// Bluesky Profile: ^https://bsky\.app/profile/[A-Za-z0-9._-]+/?$
+// Bluesky Profile: ^https://bsky\.app/profile/{actor}/?$
+// Bluesky Post: ^https://bsky\.app/profile/{actor}/post/{rkey}/?$
+// Bluesky Feed: ^https://bsky\.app/profile/{actor}/feed/{rkey}/?$
+// Bluesky List: ^https://bsky\.app/profile/{actor}/lists/{rkey}/?$
+// Bluesky Profile: ^at://{actor}$
+// Bluesky Post: ^at://{actor}/app\.bsky\.feed\.post/{rkey}$
+// Bluesky Feed: ^at://{actor}/app\.bsky\.feed\.generator/{rkey}$
+// Bluesky List: ^at://{actor}/app\.bsky\.graph\.list/{rkey}$
+//
+// Where {actor} is a handle, which is a domain name, or a DID
+// (did:plc:[a-z2-7]{24} or did:web:{domain}), and {rkey} is a record key
+// ([A-Za-z0-9._:~-]{1,512}). Handles are case-insensitive and are returned in
+// lowercase ASCII form.
 
 func decodeBlueskyURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
 		url.Scheme = "https"
 	}
-	if url.Scheme != "https" {
+
+	var actor, typ, rkey string
+	switch url.Scheme {
+	case "https":
+		if url.Host != "bsky.app" {
+			return nil, fmt.Errorf("%w: invalid Bluesky host", ErrInvalidURL)
+		}
+
+		path := strings.TrimSuffix(url.Path, "/")
+		if !strings.HasPrefix(path, "/profile/") {
+			return nil, fmt.Errorf("%w: invalid Bluesky path", ErrInvalidURL)
+		}
+
+		parts := strings.Split(strings.TrimPrefix(path, "/profile/"), "/")
+		switch len(parts) {
+		case 1:
+			actor = parts[0]
+		case 3:
+			actor, rkey = parts[0], parts[2]
+			for _, r := range blueskyRecordTypes {
+				if r.appPath == parts[1] {
+					typ = r.typ
+				}
+			}
+			if typ == "" {
+				return nil, fmt.Errorf("%w: invalid Bluesky path", ErrInvalidURL)
+			}
+		default:
+			return nil, fmt.Errorf("%w: invalid Bluesky path", ErrInvalidURL)
+		}
+
+	case "at":
+		actor = url.Host
+
+		path := strings.TrimSuffix(url.Path, "/")
+		if path != "" {
+			parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("%w: invalid Bluesky path", ErrInvalidURL)
+			}
+			rkey = parts[1]
+			for _, r := range blueskyRecordTypes {
+				if r.collection == parts[0] {
+					typ = r.typ
+				}
+			}
+			if typ == "" {
+				return nil, fmt.Errorf("%w: invalid Bluesky collection", ErrInvalidURL)
+			}
+		}
+
+	default:
 		return nil, fmt.Errorf("%w: invalid Bluesky scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "bsky.app" {
-		return nil, fmt.Errorf("%w: invalid Bluesky host", ErrInvalidURL)
+	data := map[string]string{}
+	switch {
+	case strings.HasPrefix(actor, "did:"):
+		if !isBlueskyDIDValid(actor) {
+			return nil, fmt.Errorf("%w: invalid Bluesky DID", ErrInvalidURL)
+		}
+		data["did"] = actor
+	default:
+		handle, ok := toASCIIDomain(actor)
+		if !ok || !isBlueskyHandleValid(handle) {
+			return nil, fmt.Errorf("%w: invalid Bluesky handle", ErrInvalidURL)
+		}
+		actor = handle
+		data["handle"] = handle
 	}
 
-	path := strings.TrimSuffix(url.Path, "/")
-	if !strings.HasPrefix(path, "/profile/") {
-		return nil, fmt.Errorf("%w: invalid Bluesky path", ErrInvalidURL)
+	if typ == "" {
+		return &URL{
+			Service: Bluesky,
+			Type:    "Profile",
+			ID:      actor,
+			Data:    data,
+			URL:     url,
+		}, nil
 	}
 
-	handle := strings.TrimPrefix(path, "/profile/")
-	if len(handle) < 3 || len(handle) > 253 {
-		return nil, fmt.Errorf("%w: invalid Bluesky handle length", ErrInvalidURL)
-	}
-	if strings.ContainsFunc(handle, isNotBlueskyHandleRune) {
-		return nil, fmt.Errorf("%w: invalid Bluesky handle", ErrInvalidURL)
+	if !isBlueskyRecordKeyValid(rkey) {
+		return nil, fmt.Errorf("%w: invalid Bluesky record key", ErrInvalidURL)
 	}
+	data["rkey"] = rkey
 
 	return &URL{
 		Service: Bluesky,
-		Type:    "Profile",
-		ID:      handle,
-		Data: map[string]string{
-			"handle": handle,
-		},
-		URL: url,
+		Type:    typ,
+		ID:      actor + "/" + rkey,
+		Data:    data,
+		URL:     url,
 	}, nil
 }
 
-const blueskyHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789.-"
+// blueskyRecordTypes maps the types of Bluesky records to their collection
+// NSIDs in AT-URIs and their path segments on bsky.app.
+var blueskyRecordTypes = []struct {
+	typ        string
+	collection string
+	appPath    string
+}{
+	{"Post", "app.bsky.feed.post", "post"},
+	{"Feed", "app.bsky.feed.generator", "feed"},
+	{"List", "app.bsky.graph.list", "lists"},
+}
+
+// BlueskyATURI returns the AT-URI of the profile or record that u, a parsed
+// Bluesky URL, refers to.
+func BlueskyATURI(u *URL) (string, error) {
+	actor, collection, _, err := blueskyURLParts(u)
+	if err != nil {
+		return "", err
+	}
+	if collection == "" {
+		return "at://" + actor, nil
+	}
+	return "at://" + actor + "/" + collection + "/" + u.Data["rkey"], nil
+}
+
+// BlueskyAppURL returns the bsky.app URL of the profile or record that u, a
+// parsed Bluesky URL, refers to.
+func BlueskyAppURL(u *URL) (string, error) {
+	actor, _, appPath, err := blueskyURLParts(u)
+	if err != nil {
+		return "", err
+	}
+	if appPath == "" {
+		return "https://bsky.app/profile/" + actor, nil
+	}
+	return "https://bsky.app/profile/" + actor + "/" + appPath + "/" + u.Data["rkey"], nil
+}
+
+func blueskyURLParts(u *URL) (actor, collection, appPath string, err error) {
+	if u.Service != Bluesky {
+		return "", "", "", fmt.Errorf("%w: not a Bluesky URL", ErrInvalidURL)
+	}
+
+	actor = u.Data["did"]
+	if actor == "" {
+		actor = u.Data["handle"]
+	}
+	if actor == "" {
+		return "", "", "", fmt.Errorf("%w: missing Bluesky handle or DID", ErrInvalidURL)
+	}
+
+	if u.Type == "Profile" {
+		return actor, "", "", nil
+	}
+	for _, r := range blueskyRecordTypes {
+		if r.typ == u.Type {
+			return actor, r.collection, r.appPath, nil
+		}
+	}
+	return "", "", "", fmt.Errorf("%w: invalid Bluesky type", ErrInvalidURL)
+}
+
+// A BlueskyHandleResolver looks up the records that a Bluesky handle uses to
+// declare its DID. A *net.Resolver does not implement it on its own, as it has
+// no LookupWellKnownDID, but a type that embeds one gets its LookupTXT and
+// only needs to add LookupWellKnownDID.
+type BlueskyHandleResolver interface {
+	// LookupTXT returns the DNS TXT records for name.
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+
+	// LookupWellKnownDID returns the contents of
+	// https://{handle}/.well-known/atproto-did.
+	LookupWellKnownDID(ctx context.Context, handle string) (string, error)
+}
+
+// VerifyBlueskyHandle resolves the handle of u, a parsed Bluesky URL, to a DID
+// using r. The "did=" TXT record at _atproto.{handle} is tried first, then the
+// /.well-known/atproto-did file on the handle's domain. If neither declares a
+// valid DID, it returns ErrUnverifiedHandle.
+func VerifyBlueskyHandle(ctx context.Context, r BlueskyHandleResolver, u *URL) (string, error) {
+	if u.Service != Bluesky {
+		return "", fmt.Errorf("%w: not a Bluesky URL", ErrInvalidURL)
+	}
+	handle := u.Data["handle"]
+	if handle == "" {
+		return "", fmt.Errorf("%w: missing Bluesky handle", ErrInvalidURL)
+	}
+
+	records, err := r.LookupTXT(ctx, "_atproto."+handle)
+	if err == nil {
+		did := ""
+		for _, record := range records {
+			value, ok := strings.CutPrefix(record, "did=")
+			if !ok {
+				continue
+			}
+			if did != "" && did != value {
+				return "", fmt.Errorf("%w: conflicting DNS records for %s", ErrUnverifiedHandle, handle)
+			}
+			did = value
+		}
+		if isBlueskyDIDValid(did) {
+			return did, nil
+		}
+	}
+
+	did, err := r.LookupWellKnownDID(ctx, handle)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrUnverifiedHandle, handle, err)
+	}
+	did = strings.TrimSpace(did)
+	if !isBlueskyDIDValid(did) {
+		return "", fmt.Errorf("%w: no valid DID declared for %s", ErrUnverifiedHandle, handle)
+	}
+	return did, nil
+}
+
+func isBlueskyHandleValid(handle string) bool {
+	if !isDomainNameValid(handle) {
+		return false
+	}
+	tld := handle[strings.LastIndex(handle, ".")+1:]
+	return !blueskyDisallowedTLDs[tld]
+}
+
+// blueskyDisallowedTLDs holds the top-level domains that are reserved for
+// special use and cannot be used in Bluesky handles.
+var blueskyDisallowedTLDs = map[string]bool{
+	"alt":       true,
+	"arpa":      true,
+	"example":   true,
+	"internal":  true,
+	"invalid":   true,
+	"local":     true,
+	"localhost": true,
+	"onion":     true,
+}
+
+func isBlueskyDIDValid(did string) bool {
+	switch {
+	case strings.HasPrefix(did, "did:plc:"):
+		id := strings.TrimPrefix(did, "did:plc:")
+		return len(id) == 24 && !strings.ContainsFunc(id, isNotBlueskyPLCRune)
+	case strings.HasPrefix(did, "did:web:"):
+		return isDomainNameValid(strings.TrimPrefix(did, "did:web:"))
+	default:
+		return false
+	}
+}
+
+func isBlueskyRecordKeyValid(rkey string) bool {
+	if len(rkey) < 1 || len(rkey) > 512 || rkey == "." || rkey == ".." {
+		return false
+	}
+	return !strings.ContainsFunc(rkey, isNotBlueskyRecordKeyRune)
+}
+
+const blueskyPLCAlpha = "abcdefghijklmnopqrstuvwxyz234567"
+
+func isNotBlueskyPLCRune(r rune) bool {
+	return !strings.ContainsRune(blueskyPLCAlpha, r)
+}
+
+const blueskyRecordKeyAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._:~-"
 
-func isNotBlueskyHandleRune(r rune) bool {
-	return !strings.ContainsRune(blueskyHandleAlpha, r)
+func isNotBlueskyRecordKeyRune(r rune) bool {
+	return !strings.ContainsRune(blueskyRecordKeyAlpha, r)
 }
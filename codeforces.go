@@ -0,0 +1,132 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Codeforces Profile: ^https://(www\.)?codeforces\.com/profile/[A-Za-z0-9_.-]{3,24}/?$
+// Codeforces Contest: ^https://(www\.)?codeforces\.com/contest/[0-9]{1,10}/?$
+// Codeforces Problem: ^https://(www\.)?codeforces\.com/contest/[0-9]{1,10}/problem/[A-Z][0-9]?/?$
+// Codeforces Problem: ^https://(www\.)?codeforces\.com/problemset/problem/[0-9]{1,10}/[A-Z][0-9]?/?$
+// Codeforces Blog Entry: ^https://(www\.)?codeforces\.com/blog/entry/[0-9]{1,10}/?$
+
+func decodeCodeforcesURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Codeforces scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "codeforces.com" && url.Host != "www.codeforces.com" {
+		return nil, fmt.Errorf("%w: invalid Codeforces host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Codeforces path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] == "profile":
+		username := parts[1]
+		if len(username) < 3 || len(username) > 24 {
+			return nil, fmt.Errorf("%w: invalid Codeforces username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotCodeforcesHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Codeforces username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Codeforces,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "contest":
+		contestID := parts[1]
+		if !isCodeforcesIDValid(contestID) {
+			return nil, fmt.Errorf("%w: invalid Codeforces contest ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Codeforces,
+			Type:    "Contest",
+			ID:      contestID,
+			Data: map[string]string{
+				"contestID": contestID,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 4 && parts[0] == "contest" && parts[2] == "problem":
+		return newCodeforcesProblemURL(url, parts[1], parts[3])
+
+	case len(parts) == 4 && parts[0] == "problemset" && parts[1] == "problem":
+		return newCodeforcesProblemURL(url, parts[2], parts[3])
+
+	case len(parts) == 3 && parts[0] == "blog" && parts[1] == "entry":
+		entryID := parts[2]
+		if !isCodeforcesIDValid(entryID) {
+			return nil, fmt.Errorf("%w: invalid Codeforces blog entry ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Codeforces,
+			Type:    "BlogEntry",
+			ID:      entryID,
+			Data: map[string]string{
+				"entryID": entryID,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Codeforces path", ErrInvalidURL)
+	}
+}
+
+// newCodeforcesProblemURL returns the problem with index, like "A" or "D2",
+// in the contest with contestID.
+func newCodeforcesProblemURL(url *url.URL, contestID, index string) (*URL, error) {
+	if !isCodeforcesIDValid(contestID) {
+		return nil, fmt.Errorf("%w: invalid Codeforces contest ID", ErrInvalidURL)
+	}
+	if len(index) < 1 || len(index) > 2 || index[0] < 'A' || index[0] > 'Z' || (len(index) == 2 && (index[1] < '0' || index[1] > '9')) {
+		return nil, fmt.Errorf("%w: invalid Codeforces problem index", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Codeforces,
+		Type:    "Problem",
+		ID:      contestID + "/" + index,
+		Data: map[string]string{
+			"contestID": contestID,
+			"index":     index,
+		},
+		URL: url,
+	}, nil
+}
+
+func isCodeforcesIDValid(id string) bool {
+	return len(id) >= 1 && len(id) <= 10 && !strings.ContainsFunc(id, isNotCodeforcesIDRune)
+}
+
+const codeforcesHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_.-"
+
+func isNotCodeforcesHandleRune(r rune) bool {
+	return !strings.ContainsRune(codeforcesHandleAlpha, r)
+}
+
+const codeforcesIDAlpha = "0123456789"
+
+func isNotCodeforcesIDRune(r rune) bool {
+	return !strings.ContainsRune(codeforcesIDAlpha, r)
+}
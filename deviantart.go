@@ -7,6 +7,9 @@ import (
 )
 
 // DeviantArt Profile: ^https://(www\.)?deviantart\.com/[A-Za-z0-9-]{1,20}/?$
+// DeviantArt Profile: ^https://[A-Za-z0-9-]{1,20}\.deviantart\.com/?$
+// DeviantArt Deviation: ^https://(www\.)?deviantart\.com/[A-Za-z0-9-]{1,20}/art/([A-Za-z0-9-]+-)?[0-9]{1,20}/?$
+// DeviantArt Deviation: ^https://[A-Za-z0-9-]{1,20}\.deviantart\.com/art/([A-Za-z0-9-]+-)?[0-9]{1,20}/?$
 
 func decodeDeviantArtURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,16 +19,37 @@ func decodeDeviantArtURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid DeviantArt scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "deviantart.com" && url.Host != "www.deviantart.com" {
-		return nil, fmt.Errorf("%w: invalid DeviantArt host", ErrInvalidURL)
-	}
-
 	path := strings.TrimSuffix(url.Path, "/")
-	if len(path) < 1 || path[0] != '/' {
+	if len(path) > 0 && path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid DeviantArt path", ErrInvalidURL)
 	}
+	var parts []string
+	if path != "" {
+		parts = strings.Split(strings.TrimPrefix(path, "/"), "/")
+	}
+
+	switch {
+	case url.Host == "deviantart.com" || url.Host == "www.deviantart.com":
+		if len(parts) < 1 || deviantartReservedPaths[parts[0]] {
+			return nil, fmt.Errorf("%w: invalid DeviantArt path", ErrInvalidURL)
+		}
+		return newDeviantArtUserURL(url, parts[0], parts[1:])
+
+	case strings.HasSuffix(url.Host, ".deviantart.com"):
+		username := strings.TrimSuffix(url.Host, ".deviantart.com")
+		if deviantartReservedSubdomains[username] {
+			return nil, fmt.Errorf("%w: invalid DeviantArt username", ErrInvalidURL)
+		}
+		return newDeviantArtUserURL(url, username, parts)
+
+	default:
+		return nil, fmt.Errorf("%w: invalid DeviantArt host", ErrInvalidURL)
+	}
+}
 
-	username := strings.TrimPrefix(path, "/")
+// newDeviantArtUserURL returns the profile of the given user, or one of their
+// deviations if rest, the path segments after the user, refers to one.
+func newDeviantArtUserURL(url *url.URL, username string, rest []string) (*URL, error) {
 	if len(username) < 1 || len(username) > 20 {
 		return nil, fmt.Errorf("%w: invalid DeviantArt username length", ErrInvalidURL)
 	}
@@ -33,15 +57,84 @@ func decodeDeviantArtURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid DeviantArt username", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: DeviantArt,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch {
+	case len(rest) == 0:
+		return &URL{
+			Service: DeviantArt,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(rest) == 2 && rest[0] == "art":
+		slug, deviationID := "", rest[1]
+		if i := strings.LastIndexByte(deviationID, '-'); i >= 0 {
+			slug, deviationID = deviationID[:i], deviationID[i+1:]
+		}
+		if len(deviationID) < 1 || len(deviationID) > 20 {
+			return nil, fmt.Errorf("%w: invalid DeviantArt deviation ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(deviationID, isNotDeviantArtIDRune) {
+			return nil, fmt.Errorf("%w: invalid DeviantArt deviation ID", ErrInvalidURL)
+		}
+
+		data := map[string]string{
+			"username":    username,
+			"deviationID": deviationID,
+		}
+		if slug != "" {
+			data["slug"] = slug
+		}
+
+		return &URL{
+			Service: DeviantArt,
+			Type:    "Deviation",
+			ID:      deviationID,
+			Data:    data,
+			URL:     url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid DeviantArt path", ErrInvalidURL)
+	}
+}
+
+// deviantartReservedPaths holds the first segments of DeviantArt's own routes,
+// like /daily-deviations and /tag/{name}, which deviantart.com/{user} must not
+// be confused with.
+var deviantartReservedPaths = map[string]bool{
+	"about":            true,
+	"core-membership":  true,
+	"daily-deviations": true,
+	"developers":       true,
+	"join":             true,
+	"notifications":    true,
+	"popular":          true,
+	"search":           true,
+	"settings":         true,
+	"shop":             true,
+	"tag":              true,
+	"topic":            true,
+	"users":            true,
+	"watch":            true,
+}
+
+// deviantartReservedSubdomains holds the subdomains DeviantArt kept for itself
+// when every user had one, before profiles moved to deviantart.com/{user}.
+var deviantartReservedSubdomains = map[string]bool{
+	"about":    true,
+	"api":      true,
+	"backend":  true,
+	"chat":     true,
+	"comments": true,
+	"forum":    true,
+	"help":     true,
+	"my":       true,
+	"shop":     true,
+	"www":      true,
 }
 
 const deviantartHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
@@ -49,3 +142,9 @@ const deviantartHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvw
 func isNotDeviantArtHandleRune(r rune) bool {
 	return !strings.ContainsRune(deviantartHandleAlpha, r)
 }
+
+const deviantartIDAlpha = "0123456789"
+
+func isNotDeviantArtIDRune(r rune) bool {
+	return !strings.ContainsRune(deviantartIDAlpha, r)
+}
@@ -0,0 +1,122 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DEV Profile: ^https://dev\.to/[a-z0-9_]{1,30}/?$
+// DEV Article: ^https://dev\.to/[a-z0-9_]{1,30}/[a-z0-9-]{1,200}/?$
+//
+// Organizations share the namespace of users, and are parsed as profiles too.
+
+func decodeDevToURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid DEV scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "dev.to" && url.Host != "www.dev.to" {
+		return nil, fmt.Errorf("%w: invalid DEV host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid DEV path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	if (len(parts) != 1 && len(parts) != 2) || devToReservedPaths[parts[0]] {
+		return nil, fmt.Errorf("%w: invalid DEV path", ErrInvalidURL)
+	}
+
+	username := parts[0]
+	if len(username) < 1 || len(username) > 30 {
+		return nil, fmt.Errorf("%w: invalid DEV username length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(username, isNotDevToUsernameRune) {
+		return nil, fmt.Errorf("%w: invalid DEV username", ErrInvalidURL)
+	}
+
+	if len(parts) == 1 {
+		return &URL{
+			Service: DevTo,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+	}
+
+	// Article slugs end in a short random suffix that keeps them unique per
+	// author, so the slug is kept whole.
+	slug := parts[1]
+	if len(slug) < 1 || len(slug) > 200 {
+		return nil, fmt.Errorf("%w: invalid DEV article slug length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(slug, isNotDevToSlugRune) {
+		return nil, fmt.Errorf("%w: invalid DEV article slug", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: DevTo,
+		Type:    "Article",
+		ID:      username + "/" + slug,
+		Data: map[string]string{
+			"username": username,
+			"slug":     slug,
+		},
+		URL: url,
+	}, nil
+}
+
+// devToReservedPaths holds the pages of the site, which share the top level of
+// dev.to with users and organizations, like "t" for tags and "p" for static
+// pages.
+var devToReservedPaths = map[string]bool{
+	"about":           true,
+	"api":             true,
+	"billboards":      true,
+	"code-of-conduct": true,
+	"connect":         true,
+	"contact":         true,
+	"dashboard":       true,
+	"enter":           true,
+	"faq":             true,
+	"latest":          true,
+	"listings":        true,
+	"new":             true,
+	"notifications":   true,
+	"organizations":   true,
+	"p":               true,
+	"pod":             true,
+	"privacy":         true,
+	"readinglist":     true,
+	"search":          true,
+	"settings":        true,
+	"signout_confirm": true,
+	"sponsors":        true,
+	"t":               true,
+	"tags":            true,
+	"terms":           true,
+	"top":             true,
+	"users":           true,
+	"videos":          true,
+}
+
+const devToUsernameAlpha = "abcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotDevToUsernameRune(r rune) bool {
+	return !strings.ContainsRune(devToUsernameAlpha, r)
+}
+
+const devToSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotDevToSlugRune(r rune) bool {
+	return !strings.ContainsRune(devToSlugAlpha, r)
+}
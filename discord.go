@@ -0,0 +1,194 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Discord Invite: ^https://discord\.gg/[A-Za-z0-9-]{2,32}/?$
+// Discord Invite: ^https://(www\.)?discord(app)?\.com/invite/[A-Za-z0-9-]{2,32}/?$
+// Discord Server: ^https://(www\.)?discord\.com/servers/([A-Za-z0-9-]+-)?{snowflake}/?$
+// Discord User: ^https://(www\.|ptb\.|canary\.)?discord(app)?\.com/users/{snowflake}/?$
+// Discord Channel: ^https://(www\.|ptb\.|canary\.)?discord(app)?\.com/channels/({snowflake}|@me)/{snowflake}/?$
+// Discord Message: ^https://(www\.|ptb\.|canary\.)?discord(app)?\.com/channels/({snowflake}|@me)/{snowflake}/{snowflake}/?$
+//
+// Where {snowflake} is a Discord ID ([0-9]{17,20}), which embeds the time the
+// resource was created. See DiscordSnowflakeTime.
+
+func decodeDiscordURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Discord scheme", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Discord path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch url.Host {
+	case "discord.gg":
+		if len(parts) != 1 {
+			return nil, fmt.Errorf("%w: invalid Discord path", ErrInvalidURL)
+		}
+		return newDiscordInviteURL(url, parts[0])
+
+	case "discord.com", "www.discord.com", "ptb.discord.com", "canary.discord.com", "discordapp.com", "www.discordapp.com":
+		switch {
+		case len(parts) == 2 && parts[0] == "invite":
+			return newDiscordInviteURL(url, parts[1])
+
+		case len(parts) == 2 && parts[0] == "servers":
+			slug, guildID := "", parts[1]
+			if i := strings.LastIndexByte(guildID, '-'); i >= 0 {
+				slug, guildID = guildID[:i], guildID[i+1:]
+			}
+			if !isDiscordSnowflakeValid(guildID) {
+				return nil, fmt.Errorf("%w: invalid Discord server ID", ErrInvalidURL)
+			}
+
+			data := map[string]string{
+				"guildID": guildID,
+			}
+			if slug != "" {
+				data["slug"] = slug
+			}
+
+			return &URL{
+				Service: Discord,
+				Type:    "Server",
+				ID:      guildID,
+				Data:    data,
+				URL:     url,
+			}, nil
+
+		case len(parts) == 2 && parts[0] == "users":
+			userID := parts[1]
+			if !isDiscordSnowflakeValid(userID) {
+				return nil, fmt.Errorf("%w: invalid Discord user ID", ErrInvalidURL)
+			}
+
+			return &URL{
+				Service: Discord,
+				Type:    "User",
+				ID:      userID,
+				Data: map[string]string{
+					"userID": userID,
+				},
+				URL: url,
+			}, nil
+
+		case (len(parts) == 3 || len(parts) == 4) && parts[0] == "channels":
+			return newDiscordChannelURL(url, parts[1:])
+
+		default:
+			return nil, fmt.Errorf("%w: invalid Discord path", ErrInvalidURL)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Discord host", ErrInvalidURL)
+	}
+}
+
+func newDiscordInviteURL(url *url.URL, code string) (*URL, error) {
+	if len(code) < 2 || len(code) > 32 {
+		return nil, fmt.Errorf("%w: invalid Discord invite code length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(code, isNotDiscordInviteCodeRune) {
+		return nil, fmt.Errorf("%w: invalid Discord invite code", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Discord,
+		Type:    "Invite",
+		ID:      code,
+		Data: map[string]string{
+			"inviteCode": code,
+		},
+		URL: url,
+	}, nil
+}
+
+// newDiscordChannelURL returns the channel or message that ids, the guild,
+// channel and optional message IDs of a permalink, refer to. The guild is
+// "@me" for direct messages, and is then left out of Data.
+func newDiscordChannelURL(url *url.URL, ids []string) (*URL, error) {
+	data := map[string]string{}
+	if ids[0] != "@me" {
+		if !isDiscordSnowflakeValid(ids[0]) {
+			return nil, fmt.Errorf("%w: invalid Discord server ID", ErrInvalidURL)
+		}
+		data["guildID"] = ids[0]
+	}
+	if !isDiscordSnowflakeValid(ids[1]) {
+		return nil, fmt.Errorf("%w: invalid Discord channel ID", ErrInvalidURL)
+	}
+	data["channelID"] = ids[1]
+
+	if len(ids) == 2 {
+		return &URL{
+			Service: Discord,
+			Type:    "Channel",
+			ID:      ids[1],
+			Data:    data,
+			URL:     url,
+		}, nil
+	}
+
+	if !isDiscordSnowflakeValid(ids[2]) {
+		return nil, fmt.Errorf("%w: invalid Discord message ID", ErrInvalidURL)
+	}
+	data["messageID"] = ids[2]
+
+	return &URL{
+		Service: Discord,
+		Type:    "Message",
+		ID:      ids[2],
+		Data:    data,
+		URL:     url,
+	}, nil
+}
+
+// discordEpoch is the start of the first second of 2015, in milliseconds since
+// the Unix epoch, which Discord snowflake timestamps count from.
+const discordEpoch = 1420070400000
+
+// DiscordSnowflakeTime returns the time embedded in id, a Discord snowflake
+// such as the ID of a user, server, channel or message, at which the resource
+// was created.
+func DiscordSnowflakeTime(id string) (time.Time, error) {
+	if !isDiscordSnowflakeValid(id) {
+		return time.Time{}, fmt.Errorf("%w: invalid Discord snowflake %q", ErrInvalidURL, id)
+	}
+	n, _ := strconv.ParseUint(id, 10, 64)
+	return time.UnixMilli(int64(n>>22) + discordEpoch).UTC(), nil
+}
+
+// isDiscordSnowflakeValid reports whether id is a snowflake: a 64-bit unsigned
+// integer in decimal, at least 17 digits long as every ID issued since early
+// 2015 is.
+func isDiscordSnowflakeValid(id string) bool {
+	if len(id) < 17 || len(id) > 20 || strings.ContainsFunc(id, isNotDiscordIDRune) {
+		return false
+	}
+	_, err := strconv.ParseUint(id, 10, 64)
+	return err == nil
+}
+
+const discordInviteCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotDiscordInviteCodeRune(r rune) bool {
+	return !strings.ContainsRune(discordInviteCodeAlpha, r)
+}
+
+const discordIDAlpha = "0123456789"
+
+func isNotDiscordIDRune(r rune) bool {
+	return !strings.ContainsRune(discordIDAlpha, r)
+}
@@ -0,0 +1,77 @@
+package slinky
+
+import "strings"
+
+// toASCIIDomain converts name to lowercase and encodes the labels that contain
+// non-ASCII characters in Punycode, prefixed with "xn--". It does not apply
+// the full IDNA mapping, so names that rely on it may not convert exactly as
+// they would in a browser.
+func toASCIIDomain(name string) (string, bool) {
+	labels := strings.Split(strings.ToLower(name), ".")
+	for i, label := range labels {
+		if !strings.ContainsFunc(label, isNotASCIIRune) {
+			continue
+		}
+		encoded, ok := encodePunycode(label)
+		if !ok {
+			return "", false
+		}
+		labels[i] = "xn--" + encoded
+	}
+	return strings.Join(labels, "."), true
+}
+
+// normalizeHost returns hostname, the host of a URL without its port, in the
+// form the registry uses: lowercase, without a trailing dot and with
+// internationalized labels in Punycode. It returns false if hostname is not a
+// valid domain name, such as an IP address.
+func normalizeHost(hostname string) (string, bool) {
+	host, ok := toASCIIDomain(strings.TrimSuffix(hostname, "."))
+	if !ok || !isDomainNameValid(host) {
+		return "", false
+	}
+	return host, true
+}
+
+// isDomainNameValid reports whether name, in ASCII form, is a fully qualified
+// domain name: at least two labels of 1 to 63 letters, digits and inner
+// hyphens, at most 253 characters in total, and a top-level domain that does
+// not start with a digit.
+func isDomainNameValid(name string) bool {
+	if len(name) > 253 {
+		return false
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !isDomainLabelValid(label) {
+			return false
+		}
+	}
+	tld := labels[len(labels)-1]
+	return tld[0] < '0' || tld[0] > '9'
+}
+
+// isDomainLabelValid reports whether label is 1 to 63 letters, digits and
+// inner hyphens.
+func isDomainLabelValid(label string) bool {
+	if len(label) < 1 || len(label) > 63 {
+		return false
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	return !strings.ContainsFunc(label, isNotDomainLabelRune)
+}
+
+func isNotASCIIRune(r rune) bool {
+	return r >= 0x80
+}
+
+const domainLabelAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotDomainLabelRune(r rune) bool {
+	return !strings.ContainsRune(domainLabelAlpha, r)
+}
@@ -7,6 +7,7 @@ import (
 )
 
 // Dribbble Profile: ^https://(www\.)?dribbble\.com/[A-Za-z0-9_-]{1,30}/?$
+// Dribbble Shot: ^https://(www\.)?dribbble\.com/shots/[0-9]{1,20}(-[A-Za-z0-9-]{1,200})?/?$
 
 func decodeDribbbleURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -24,8 +25,48 @@ func decodeDribbbleURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Dribbble path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	if parts[0] == "shots" {
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: invalid Dribbble path", ErrInvalidURL)
+		}
+
+		shotID, slug, hasSlug := strings.Cut(parts[1], "-")
+		if len(shotID) < 1 || len(shotID) > 20 {
+			return nil, fmt.Errorf("%w: invalid Dribbble shot ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(shotID, isNotDribbbleIDRune) {
+			return nil, fmt.Errorf("%w: invalid Dribbble shot ID", ErrInvalidURL)
+		}
+
+		data := map[string]string{
+			"shotID": shotID,
+		}
+		if hasSlug {
+			if len(slug) < 1 || len(slug) > 200 {
+				return nil, fmt.Errorf("%w: invalid Dribbble shot slug length", ErrInvalidURL)
+			}
+			if strings.ContainsFunc(slug, isNotDribbbleHandleRune) {
+				return nil, fmt.Errorf("%w: invalid Dribbble shot slug", ErrInvalidURL)
+			}
+			data["slug"] = slug
+		}
+
+		return &URL{
+			Service: Dribbble,
+			Type:    "Shot",
+			ID:      shotID,
+			Data:    data,
+			URL:     url,
+		}, nil
+	}
+
+	if len(parts) != 1 || dribbbleReservedPaths[parts[0]] {
+		return nil, fmt.Errorf("%w: invalid Dribbble path", ErrInvalidURL)
+	}
+
+	username := parts[0]
 	if len(username) < 1 || len(username) > 30 {
 		return nil, fmt.Errorf("%w: invalid Dribbble username length", ErrInvalidURL)
 	}
@@ -44,8 +85,31 @@ func decodeDribbbleURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+// dribbbleReservedPaths holds the sections of dribbble.com, like /designers
+// and /tags. Any other single path segment is a profile.
+var dribbbleReservedPaths = map[string]bool{
+	"about":     true,
+	"designers": true,
+	"following": true,
+	"hiring":    true,
+	"jobs":      true,
+	"learn":     true,
+	"pro":       true,
+	"search":    true,
+	"session":   true,
+	"signup":    true,
+	"stories":   true,
+	"tags":      true,
+}
+
 const dribbbleHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
 
 func isNotDribbbleHandleRune(r rune) bool {
 	return !strings.ContainsRune(dribbbleHandleAlpha, r)
 }
+
+const dribbbleIDAlpha = "0123456789"
+
+func isNotDribbbleIDRune(r rune) bool {
+	return !strings.ContainsRune(dribbbleIDAlpha, r)
+}
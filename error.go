@@ -11,4 +11,10 @@ var (
 
 	// ErrInvalidURL is returned when the URL matches a known service but has an invalid format.
 	ErrInvalidURL = errors.New("invalid URL")
+
+	// ErrUnsupported is returned when an operation does not apply to the given URL.
+	ErrUnsupported = errors.New("unsupported operation for url")
+
+	// ErrUnverifiedHandle is returned when a handle cannot be verified to belong to an identity.
+	ErrUnverifiedHandle = errors.New("handle could not be verified")
 )
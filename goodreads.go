@@ -6,7 +6,12 @@ import (
 	"strings"
 )
 
-// Goodreads Profile: ^https://(www\.)?goodreads\.com/user/show/[0-9]+/?$
+// Goodreads Profile: ^https://(www\.)?goodreads\.com/user/show/[0-9]+([.-][A-Za-z0-9_.-]+)?/?$
+// Goodreads Profile: ^https://(www\.)?goodreads\.com/[A-Za-z0-9_]{1,30}/?$
+// Goodreads Author: ^https://(www\.)?goodreads\.com/author/show/[0-9]+([.-][A-Za-z0-9_.-]+)?/?$
+// Goodreads Book: ^https://(www\.)?goodreads\.com/book/show/[0-9]+([.-][A-Za-z0-9_.-]+)?/?$
+// Goodreads Series: ^https://(www\.)?goodreads\.com/series/[0-9]+([.-][A-Za-z0-9_.-]+)?/?$
+// Goodreads Group: ^https://(www\.)?goodreads\.com/group/show/[0-9]+([.-][A-Za-z0-9_.-]+)?/?$
 
 func decodeGoodreadsURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -21,29 +26,130 @@ func decodeGoodreadsURL(url *url.URL) (*URL, error) {
 	}
 
 	path := strings.TrimSuffix(url.Path, "/")
-	if !strings.HasPrefix(path, "/user/show/") {
+	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Goodreads path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	userID := strings.TrimPrefix(path, "/user/show/")
-	if len(userID) < 1 || len(userID) > 20 {
-		return nil, fmt.Errorf("%w: invalid Goodreads user ID length", ErrInvalidURL)
+	switch {
+	case len(parts) == 3 && parts[0] == "user" && parts[1] == "show":
+		return newGoodreadsURL(url, "Profile", "user", parts[2])
+
+	case len(parts) == 3 && parts[0] == "author" && parts[1] == "show":
+		return newGoodreadsURL(url, "Author", "author", parts[2])
+
+	case len(parts) == 3 && parts[0] == "book" && parts[1] == "show":
+		return newGoodreadsURL(url, "Book", "book", parts[2])
+
+	case len(parts) == 3 && parts[0] == "group" && parts[1] == "show":
+		return newGoodreadsURL(url, "Group", "group", parts[2])
+
+	case len(parts) == 2 && parts[0] == "series":
+		return newGoodreadsURL(url, "Series", "series", parts[1])
+
+	case len(parts) == 1 && !goodreadsReservedPaths[parts[0]]:
+		username := parts[0]
+		if len(username) < 1 || len(username) > 30 {
+			return nil, fmt.Errorf("%w: invalid Goodreads username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotGoodreadsHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Goodreads username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Goodreads,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Goodreads path", ErrInvalidURL)
+	}
+}
+
+// newGoodreadsURL returns the URL of the given type for s, a numeric ID
+// optionally followed by "." or "-" and a slug of the resource's name, like
+// "1077326.J_K_Rowling" or "2767052-the-hunger-games".
+func newGoodreadsURL(url *url.URL, typ, kind, s string) (*URL, error) {
+	id, slug := s, ""
+	if i := strings.IndexAny(s, ".-"); i >= 0 {
+		id, slug = s[:i], s[i+1:]
+		if len(slug) < 1 || len(slug) > 200 {
+			return nil, fmt.Errorf("%w: invalid Goodreads %s slug length", ErrInvalidURL, kind)
+		}
+		if strings.ContainsFunc(slug, isNotGoodreadsSlugRune) {
+			return nil, fmt.Errorf("%w: invalid Goodreads %s slug", ErrInvalidURL, kind)
+		}
+	}
+	if len(id) < 1 || len(id) > 20 {
+		return nil, fmt.Errorf("%w: invalid Goodreads %s ID length", ErrInvalidURL, kind)
 	}
-	if strings.ContainsFunc(userID, isNotGoodreadsIDRune) {
-		return nil, fmt.Errorf("%w: invalid Goodreads user ID", ErrInvalidURL)
+	if strings.ContainsFunc(id, isNotGoodreadsIDRune) {
+		return nil, fmt.Errorf("%w: invalid Goodreads %s ID", ErrInvalidURL, kind)
+	}
+
+	data := map[string]string{
+		kind + "ID": id,
+	}
+	if slug != "" {
+		data["slug"] = slug
 	}
 
 	return &URL{
 		Service: Goodreads,
-		Type:    "Profile",
-		ID:      userID,
-		Data: map[string]string{
-			"userID": userID,
-		},
-		URL: url,
+		Type:    typ,
+		ID:      id,
+		Data:    data,
+		URL:     url,
 	}, nil
 }
 
+// goodreadsReservedPaths holds the first segments of Goodreads' /{kind}/show
+// routes and its own pages, which share the top level with vanity URLs.
+var goodreadsReservedPaths = map[string]bool{
+	"about":           true,
+	"api":             true,
+	"author":          true,
+	"blog":            true,
+	"book":            true,
+	"challenges":      true,
+	"choiceawards":    true,
+	"community":       true,
+	"friend":          true,
+	"genres":          true,
+	"giveaway":        true,
+	"group":           true,
+	"help":            true,
+	"list":            true,
+	"news":            true,
+	"quotes":          true,
+	"recommendations": true,
+	"review":          true,
+	"search":          true,
+	"series":          true,
+	"shelf":           true,
+	"topic":           true,
+	"trivia":          true,
+	"user":            true,
+	"work":            true,
+}
+
+const goodreadsHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotGoodreadsHandleRune(r rune) bool {
+	return !strings.ContainsRune(goodreadsHandleAlpha, r)
+}
+
+const goodreadsSlugAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_.-"
+
+func isNotGoodreadsSlugRune(r rune) bool {
+	return !strings.ContainsRune(goodreadsSlugAlpha, r)
+}
+
 const goodreadsIDAlpha = "0123456789"
 
 func isNotGoodreadsIDRune(r rune) bool {
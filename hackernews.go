@@ -0,0 +1,78 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Hacker News Profile: ^https://news\.ycombinator\.com/(user|submitted|threads)\?id=[A-Za-z0-9_-]{2,15}$
+// Hacker News Item: ^https://news\.ycombinator\.com/item\?id=[0-9]{1,20}$
+
+func decodeHackerNewsURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Hacker News scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "news.ycombinator.com" {
+		return nil, fmt.Errorf("%w: invalid Hacker News host", ErrInvalidURL)
+	}
+
+	id := url.Query().Get("id")
+
+	switch url.Path {
+	case "/user", "/submitted", "/threads":
+		if len(id) < 2 || len(id) > 15 {
+			return nil, fmt.Errorf("%w: invalid Hacker News username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(id, isNotHackerNewsUsernameRune) {
+			return nil, fmt.Errorf("%w: invalid Hacker News username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: HackerNews,
+			Type:    "Profile",
+			ID:      id,
+			Data: map[string]string{
+				"username": id,
+			},
+			URL: url,
+		}, nil
+
+	case "/item":
+		if len(id) < 1 || len(id) > 20 {
+			return nil, fmt.Errorf("%w: invalid Hacker News item ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(id, isNotHackerNewsIDRune) {
+			return nil, fmt.Errorf("%w: invalid Hacker News item ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: HackerNews,
+			Type:    "Item",
+			ID:      id,
+			Data: map[string]string{
+				"itemID": id,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Hacker News path", ErrInvalidURL)
+	}
+}
+
+const hackerNewsUsernameAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotHackerNewsUsernameRune(r rune) bool {
+	return !strings.ContainsRune(hackerNewsUsernameAlpha, r)
+}
+
+const hackerNewsIDAlpha = "0123456789"
+
+func isNotHackerNewsIDRune(r rune) bool {
+	return !strings.ContainsRune(hackerNewsIDAlpha, r)
+}
@@ -0,0 +1,123 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// HackerRank Profile: ^https://(www\.)?hackerrank\.com/(profile/)?[A-Za-z0-9_.-]{1,50}/?$
+// HackerRank Challenge: ^https://(www\.)?hackerrank\.com/challenges/[a-z0-9-]{1,100}(/problem)?/?$
+// HackerRank Contest: ^https://(www\.)?hackerrank\.com/contests/[a-z0-9-]{1,100}/?$
+
+func decodeHackerRankURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid HackerRank scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "hackerrank.com" && url.Host != "www.hackerrank.com" {
+		return nil, fmt.Errorf("%w: invalid HackerRank host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid HackerRank path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var username string
+	switch {
+	case (len(parts) == 2 || (len(parts) == 3 && parts[2] == "problem")) && parts[0] == "challenges":
+		return newHackerRankSlugURL(url, "Challenge", "challenge", parts[1])
+
+	case len(parts) == 2 && parts[0] == "contests":
+		return newHackerRankSlugURL(url, "Contest", "contest", parts[1])
+
+	case len(parts) == 2 && parts[0] == "profile":
+		username = parts[1]
+
+	case len(parts) == 1 && !hackerRankReservedPaths[parts[0]]:
+		username = parts[0]
+
+	default:
+		return nil, fmt.Errorf("%w: invalid HackerRank path", ErrInvalidURL)
+	}
+
+	if len(username) < 1 || len(username) > 50 {
+		return nil, fmt.Errorf("%w: invalid HackerRank username length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(username, isNotHackerRankUsernameRune) {
+		return nil, fmt.Errorf("%w: invalid HackerRank username", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: HackerRank,
+		Type:    "Profile",
+		ID:      username,
+		Data: map[string]string{
+			"username": username,
+		},
+		URL: url,
+	}, nil
+}
+
+// newHackerRankSlugURL returns the typ with slug, keyed by kind in Data.
+func newHackerRankSlugURL(url *url.URL, typ, kind, slug string) (*URL, error) {
+	if len(slug) < 1 || len(slug) > 100 {
+		return nil, fmt.Errorf("%w: invalid HackerRank %s length", ErrInvalidURL, kind)
+	}
+	if strings.ContainsFunc(slug, isNotHackerRankSlugRune) {
+		return nil, fmt.Errorf("%w: invalid HackerRank %s", ErrInvalidURL, kind)
+	}
+
+	return &URL{
+		Service: HackerRank,
+		Type:    typ,
+		ID:      slug,
+		Data: map[string]string{
+			kind: slug,
+		},
+		URL: url,
+	}, nil
+}
+
+// hackerRankReservedPaths holds the first segments of HackerRank's own routes.
+// hackerrank.com/{user} still redirects to /profile/{user}, so a single
+// segment that is not one of these is taken for a username.
+var hackerRankReservedPaths = map[string]bool{
+	"about-us":            true,
+	"auth":                true,
+	"blog":                true,
+	"certify":             true,
+	"challenges":          true,
+	"contests":            true,
+	"dashboard":           true,
+	"domains":             true,
+	"interview":           true,
+	"jobs":                true,
+	"leaderboard":         true,
+	"login":               true,
+	"prepare":             true,
+	"products":            true,
+	"profile":             true,
+	"settings":            true,
+	"signup":              true,
+	"skills-verification": true,
+	"support":             true,
+	"work":                true,
+}
+
+const hackerRankUsernameAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_.-"
+
+func isNotHackerRankUsernameRune(r rune) bool {
+	return !strings.ContainsRune(hackerRankUsernameAlpha, r)
+}
+
+const hackerRankSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotHackerRankSlugRune(r rune) bool {
+	return !strings.ContainsRune(hackerRankSlugAlpha, r)
+}
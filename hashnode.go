@@ -0,0 +1,113 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Hashnode Profile: ^https://(www\.)?hashnode\.com/@[A-Za-z0-9_-]{1,50}/?$
+// Hashnode Blog: ^https://[a-z0-9-]{1,63}\.hashnode\.dev/?$
+// Hashnode Post: ^https://[a-z0-9-]{1,63}\.hashnode\.dev/[a-z0-9-]{1,250}/?$
+//
+// Blogs on custom domains are not recognized.
+
+func decodeHashnodeURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Hashnode scheme", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+
+	if url.Host == "hashnode.com" || url.Host == "www.hashnode.com" {
+		username, ok := strings.CutPrefix(path, "/@")
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid Hashnode path", ErrInvalidURL)
+		}
+		if len(username) < 1 || len(username) > 50 {
+			return nil, fmt.Errorf("%w: invalid Hashnode username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotHashnodeUsernameRune) {
+			return nil, fmt.Errorf("%w: invalid Hashnode username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Hashnode,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+	}
+
+	blog, ok := strings.CutSuffix(url.Host, ".hashnode.dev")
+	if !ok || strings.Contains(blog, ".") || hashnodeReservedSubdomains[blog] {
+		return nil, fmt.Errorf("%w: invalid Hashnode host", ErrInvalidURL)
+	}
+
+	if path == "" {
+		return &URL{
+			Service: Hashnode,
+			Type:    "Blog",
+			ID:      blog,
+			Data: map[string]string{
+				"blog": blog,
+			},
+			URL: url,
+		}, nil
+	}
+
+	slug := strings.TrimPrefix(path, "/")
+	if len(slug) < 1 || len(slug) > 250 || hashnodeReservedPaths[slug] {
+		return nil, fmt.Errorf("%w: invalid Hashnode path", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(slug, isNotHashnodeSlugRune) {
+		return nil, fmt.Errorf("%w: invalid Hashnode post slug", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Hashnode,
+		Type:    "Post",
+		ID:      blog + "/" + slug,
+		Data: map[string]string{
+			"blog": blog,
+			"slug": slug,
+		},
+		URL: url,
+	}, nil
+}
+
+// hashnodeReservedSubdomains holds the subdomains of hashnode.dev that are not
+// blogs, like api and gql for Hashnode's REST and GraphQL APIs.
+var hashnodeReservedSubdomains = map[string]bool{
+	"api": true,
+	"gql": true,
+	"www": true,
+}
+
+// hashnodeReservedPaths holds the archive, series, tag and newsletter pages
+// every Hashnode blog has at the same depth as its posts.
+var hashnodeReservedPaths = map[string]bool{
+	"archive":    true,
+	"newsletter": true,
+	"search":     true,
+	"series":     true,
+	"tag":        true,
+}
+
+const hashnodeUsernameAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotHashnodeUsernameRune(r rune) bool {
+	return !strings.ContainsRune(hashnodeUsernameAlpha, r)
+}
+
+const hashnodeSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotHashnodeSlugRune(r rune) bool {
+	return !strings.ContainsRune(hashnodeSlugAlpha, r)
+}
@@ -0,0 +1,138 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Kaggle Profile: ^https://(www\.)?kaggle\.com/[a-z0-9]{3,20}/?$
+// Kaggle Competition: ^https://(www\.)?kaggle\.com/(competitions|c)/[a-z0-9-]{1,100}/?$
+// Kaggle Dataset: ^https://(www\.)?kaggle\.com/datasets/[a-z0-9]{3,20}/[a-z0-9-]{1,100}/?$
+// Kaggle Notebook: ^https://(www\.)?kaggle\.com/code/[a-z0-9]{3,20}/[a-z0-9-]{1,100}/?$
+
+func decodeKaggleURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Kaggle scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "kaggle.com" && url.Host != "www.kaggle.com" {
+		return nil, fmt.Errorf("%w: invalid Kaggle host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Kaggle path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case len(parts) == 2 && (parts[0] == "competitions" || parts[0] == "c"):
+		competition := parts[1]
+		if !isKaggleSlugValid(competition) {
+			return nil, fmt.Errorf("%w: invalid Kaggle competition", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Kaggle,
+			Type:    "Competition",
+			ID:      competition,
+			Data: map[string]string{
+				"competition": competition,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[0] == "datasets":
+		return newKaggleItemURL(url, "Dataset", "dataset", parts[1], parts[2])
+
+	case len(parts) == 3 && parts[0] == "code":
+		return newKaggleItemURL(url, "Notebook", "notebook", parts[1], parts[2])
+
+	case len(parts) == 1 && !kaggleReservedPaths[parts[0]]:
+		username := parts[0]
+		if !isKaggleUsernameValid(username) {
+			return nil, fmt.Errorf("%w: invalid Kaggle username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Kaggle,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Kaggle path", ErrInvalidURL)
+	}
+}
+
+// newKaggleItemURL returns the typ owned by username, with its slug keyed by
+// kind in Data.
+func newKaggleItemURL(url *url.URL, typ, kind, username, slug string) (*URL, error) {
+	if !isKaggleUsernameValid(username) {
+		return nil, fmt.Errorf("%w: invalid Kaggle username", ErrInvalidURL)
+	}
+	if !isKaggleSlugValid(slug) {
+		return nil, fmt.Errorf("%w: invalid Kaggle %s", ErrInvalidURL, kind)
+	}
+
+	return &URL{
+		Service: Kaggle,
+		Type:    typ,
+		ID:      username + "/" + slug,
+		Data: map[string]string{
+			"username": username,
+			kind:       slug,
+		},
+		URL: url,
+	}, nil
+}
+
+// kaggleReservedPaths holds the sections of kaggle.com. Listings like /code
+// and /datasets are among them, so that they are not read as the profiles of
+// users with those names.
+var kaggleReservedPaths = map[string]bool{
+	"account":       true,
+	"benchmarks":    true,
+	"code":          true,
+	"competitions":  true,
+	"contact":       true,
+	"datasets":      true,
+	"discussions":   true,
+	"docs":          true,
+	"learn":         true,
+	"models":        true,
+	"organizations": true,
+	"privacy":       true,
+	"rankings":      true,
+	"search":        true,
+	"settings":      true,
+	"terms":         true,
+}
+
+func isKaggleUsernameValid(username string) bool {
+	return len(username) >= 3 && len(username) <= 20 && !strings.ContainsFunc(username, isNotKaggleUsernameRune)
+}
+
+func isKaggleSlugValid(slug string) bool {
+	return len(slug) >= 1 && len(slug) <= 100 && !strings.ContainsFunc(slug, isNotKaggleSlugRune)
+}
+
+const kaggleUsernameAlpha = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotKaggleUsernameRune(r rune) bool {
+	return !strings.ContainsRune(kaggleUsernameAlpha, r)
+}
+
+const kaggleSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotKaggleSlugRune(r rune) bool {
+	return !strings.ContainsRune(kaggleSlugAlpha, r)
+}
@@ -0,0 +1,103 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// KakaoTalk OpenChat: ^https://open\.kakao\.com/o/[A-Za-z0-9]{4,16}/?$
+// KakaoTalk Profile: ^https://open\.kakao\.com/me/[A-Za-z0-9_]{2,32}/?$
+// KakaoTalk Channel: ^https://pf\.kakao\.com/_[A-Za-z0-9]{2,20}/?$
+
+func decodeKakaoTalkURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid KakaoTalk scheme", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid KakaoTalk path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case url.Host == "open.kakao.com" && len(parts) == 2 && parts[0] == "o":
+		code := parts[1]
+		if len(code) < 4 || len(code) > 16 {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk open chat code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotKakaoTalkCodeRune) {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk open chat code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: KakaoTalk,
+			Type:    "OpenChat",
+			ID:      code,
+			Data: map[string]string{
+				"code": code,
+			},
+			URL: url,
+		}, nil
+
+	case url.Host == "open.kakao.com" && len(parts) == 2 && parts[0] == "me":
+		username := parts[1]
+		if len(username) < 2 || len(username) > 32 {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotKakaoTalkHandleRune) {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: KakaoTalk,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case url.Host == "pf.kakao.com" && len(parts) == 1:
+		channelID := parts[0]
+		if len(channelID) < 3 || len(channelID) > 21 {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk channel ID length", ErrInvalidURL)
+		}
+		if channelID[0] != '_' || strings.ContainsFunc(channelID[1:], isNotKakaoTalkCodeRune) {
+			return nil, fmt.Errorf("%w: invalid KakaoTalk channel ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: KakaoTalk,
+			Type:    "Channel",
+			ID:      channelID,
+			Data: map[string]string{
+				"channelID": channelID,
+			},
+			URL: url,
+		}, nil
+
+	case url.Host == "open.kakao.com" || url.Host == "pf.kakao.com":
+		return nil, fmt.Errorf("%w: invalid KakaoTalk path", ErrInvalidURL)
+
+	default:
+		return nil, fmt.Errorf("%w: invalid KakaoTalk host", ErrInvalidURL)
+	}
+}
+
+const kakaotalkHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotKakaoTalkHandleRune(r rune) bool {
+	return !strings.ContainsRune(kakaotalkHandleAlpha, r)
+}
+
+const kakaotalkCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotKakaoTalkCodeRune(r rune) bool {
+	return !strings.ContainsRune(kakaotalkCodeAlpha, r)
+}
@@ -0,0 +1,97 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Keybase Profile: ^https://keybase\.io/[a-z0-9_]{2,16}/?$
+// Keybase Team: ^https://keybase\.io/team/[a-z0-9_.]{2,255}/?$
+
+func decodeKeybaseURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Keybase scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "keybase.io" && url.Host != "www.keybase.io" {
+		return nil, fmt.Errorf("%w: invalid Keybase host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Keybase path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] == "team":
+		// Subteams are named after their parent, joined by dots.
+		team := parts[1]
+		if len(team) < 2 || len(team) > 255 {
+			return nil, fmt.Errorf("%w: invalid Keybase team length", ErrInvalidURL)
+		}
+		for _, name := range strings.Split(team, ".") {
+			if len(name) < 2 || len(name) > 16 || strings.ContainsFunc(name, isNotKeybaseHandleRune) {
+				return nil, fmt.Errorf("%w: invalid Keybase team", ErrInvalidURL)
+			}
+		}
+
+		return &URL{
+			Service: Keybase,
+			Type:    "Team",
+			ID:      team,
+			Data: map[string]string{
+				"team": team,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 1 && !keybaseReservedPaths[parts[0]]:
+		username := parts[0]
+		if len(username) < 2 || len(username) > 16 {
+			return nil, fmt.Errorf("%w: invalid Keybase username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotKeybaseHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Keybase username", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Keybase,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Keybase path", ErrInvalidURL)
+	}
+}
+
+// keybaseReservedPaths holds the pages of keybase.io, like /docs and
+// /download, that a bare /{name} is checked against before it is read as a
+// username. /team on its own is here too, as teams are only under it.
+var keybaseReservedPaths = map[string]bool{
+	"account":  true,
+	"blog":     true,
+	"docs":     true,
+	"download": true,
+	"inbox":    true,
+	"jobs":     true,
+	"login":    true,
+	"search":   true,
+	"signup":   true,
+	"team":     true,
+}
+
+const keybaseHandleAlpha = "abcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotKeybaseHandleRune(r rune) bool {
+	return !strings.ContainsRune(keybaseHandleAlpha, r)
+}
@@ -7,6 +7,9 @@ import (
 )
 
 // Kick Channel: ^https://(www\.)?kick\.com/[A-Za-z0-9_]{4,25}/?$
+// Kick Video: ^https://(www\.)?kick\.com/[A-Za-z0-9_]{4,25}/videos/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/?$
+// Kick Clip: ^https://(www\.)?kick\.com/[A-Za-z0-9_]{4,25}/?\?clip=[A-Za-z0-9_]{1,64}$
+// Kick Clip: ^https://(www\.)?kick\.com/[A-Za-z0-9_]{4,25}/clips/[A-Za-z0-9_]{1,64}/?$
 
 func decodeKickURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -24,8 +27,12 @@ func decodeKickURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Kick path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	username := parts[0]
+	if kickReservedPaths[username] {
+		return nil, fmt.Errorf("%w: invalid Kick path", ErrInvalidURL)
+	}
 	if len(username) < 4 || len(username) > 25 {
 		return nil, fmt.Errorf("%w: invalid Kick username length", ErrInvalidURL)
 	}
@@ -33,19 +40,112 @@ func decodeKickURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Kick username", ErrInvalidURL)
 	}
 
+	switch {
+	case len(parts) == 1 && url.Query().Has("clip"):
+		return newKickClipURL(url, username, url.Query().Get("clip"))
+
+	case len(parts) == 1:
+		return &URL{
+			Service: Kick,
+			Type:    "Channel",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[1] == "clips":
+		return newKickClipURL(url, username, parts[2])
+
+	case len(parts) == 3 && parts[1] == "videos":
+		videoID := parts[2]
+		if !isKickVideoIDValid(videoID) {
+			return nil, fmt.Errorf("%w: invalid Kick video ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Kick,
+			Type:    "Video",
+			ID:      videoID,
+			Data: map[string]string{
+				"username": username,
+				"videoID":  videoID,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Kick path", ErrInvalidURL)
+	}
+}
+
+func newKickClipURL(url *url.URL, username, clipID string) (*URL, error) {
+	if len(clipID) < 1 || len(clipID) > 64 {
+		return nil, fmt.Errorf("%w: invalid Kick clip ID length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(clipID, isNotKickHandleRune) {
+		return nil, fmt.Errorf("%w: invalid Kick clip ID", ErrInvalidURL)
+	}
+
 	return &URL{
 		Service: Kick,
-		Type:    "Channel",
-		ID:      username,
+		Type:    "Clip",
+		ID:      clipID,
 		Data: map[string]string{
 			"username": username,
+			"clipID":   clipID,
 		},
 		URL: url,
 	}, nil
 }
 
+// isKickVideoIDValid reports whether id is a lowercase hyphenated UUID, the
+// form Kick identifies past broadcasts by.
+func isKickVideoIDValid(id string) bool {
+	if len(id) != 36 {
+		return false
+	}
+	for i, r := range id {
+		switch i {
+		case 8, 13, 18, 23:
+			if r != '-' {
+				return false
+			}
+		default:
+			if isNotKickVideoIDRune(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// kickReservedPaths holds the pages of kick.com, like /browse and
+// /categories, that sit where channel names do.
+var kickReservedPaths = map[string]bool{
+	"about":                true,
+	"browse":               true,
+	"categories":           true,
+	"category":             true,
+	"community-guidelines": true,
+	"dashboard":            true,
+	"following":            true,
+	"privacy-policy":       true,
+	"search":               true,
+	"settings":             true,
+	"terms-of-service":     true,
+	"video":                true,
+}
+
 const kickHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
 
 func isNotKickHandleRune(r rune) bool {
 	return !strings.ContainsRune(kickHandleAlpha, r)
 }
+
+const kickVideoIDAlpha = "0123456789abcdef"
+
+func isNotKickVideoIDRune(r rune) bool {
+	return !strings.ContainsRune(kickVideoIDAlpha, r)
+}
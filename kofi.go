@@ -7,6 +7,11 @@ import (
 )
 
 // Ko-fi Profile: ^https://ko-fi\.com/[A-Za-z0-9_]{3,40}/?$
+// Ko-fi Shop: ^https://ko-fi\.com/[A-Za-z0-9_]{3,40}/shop/?$
+// Ko-fi Commissions: ^https://ko-fi\.com/[A-Za-z0-9_]{3,40}/commissions/?$
+// Ko-fi ShopItem: ^https://ko-fi\.com/s/[A-Za-z0-9]{1,20}/?$
+// Ko-fi Commission: ^https://ko-fi\.com/c/[A-Za-z0-9]{1,20}/?$
+// Ko-fi Post: ^https://ko-fi\.com/post/([A-Za-z0-9-]+-)?[A-Za-z0-9]{1,20}/?$
 
 func decodeKofiURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -24,8 +29,34 @@ func decodeKofiURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Ko-fi path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "s":
+		return newKofiItemURL(url, "ShopItem", "itemID", parts[1])
+
+	case len(parts) == 2 && parts[0] == "c":
+		return newKofiItemURL(url, "Commission", "commissionID", parts[1])
+
+	case len(parts) == 2 && parts[0] == "post":
+		slug, postID := "", parts[1]
+		if i := strings.LastIndexByte(postID, '-'); i >= 0 {
+			slug, postID = postID[:i], postID[i+1:]
+		}
+		u, err := newKofiItemURL(url, "Post", "postID", postID)
+		if err != nil {
+			return nil, err
+		}
+		if slug != "" {
+			u.Data["slug"] = slug
+		}
+		return u, nil
+
+	case kofiReservedPaths[parts[0]]:
+		return nil, fmt.Errorf("%w: invalid Ko-fi path", ErrInvalidURL)
+	}
+
+	username := parts[0]
 	if len(username) < 3 || len(username) > 40 {
 		return nil, fmt.Errorf("%w: invalid Ko-fi username length", ErrInvalidURL)
 	}
@@ -33,9 +64,21 @@ func decodeKofiURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Ko-fi username", ErrInvalidURL)
 	}
 
+	var typ string
+	switch {
+	case len(parts) == 1:
+		typ = "Profile"
+	case len(parts) == 2 && parts[1] == "shop":
+		typ = "Shop"
+	case len(parts) == 2 && parts[1] == "commissions":
+		typ = "Commissions"
+	default:
+		return nil, fmt.Errorf("%w: invalid Ko-fi path", ErrInvalidURL)
+	}
+
 	return &URL{
 		Service: Kofi,
-		Type:    "Profile",
+		Type:    typ,
 		ID:      username,
 		Data: map[string]string{
 			"username": username,
@@ -44,8 +87,53 @@ func decodeKofiURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+// newKofiItemURL returns the URL of the given type for a shop item,
+// commission or post, identified by id and stored in Data under key.
+func newKofiItemURL(url *url.URL, typ, key, id string) (*URL, error) {
+	if len(id) < 1 || len(id) > 20 {
+		return nil, fmt.Errorf("%w: invalid Ko-fi %s ID length", ErrInvalidURL, strings.TrimSuffix(key, "ID"))
+	}
+	if strings.ContainsFunc(id, isNotKofiIDRune) {
+		return nil, fmt.Errorf("%w: invalid Ko-fi %s ID", ErrInvalidURL, strings.TrimSuffix(key, "ID"))
+	}
+
+	return &URL{
+		Service: Kofi,
+		Type:    typ,
+		ID:      id,
+		Data: map[string]string{
+			key: id,
+		},
+		URL: url,
+	}, nil
+}
+
+// kofiReservedPaths holds Ko-fi's own pages and the "c", "s" and "post"
+// prefixes of commission, shop item and post links.
+var kofiReservedPaths = map[string]bool{
+	"about":    true,
+	"account":  true,
+	"c":        true,
+	"explore":  true,
+	"feed":     true,
+	"gold":     true,
+	"home":     true,
+	"login":    true,
+	"manage":   true,
+	"post":     true,
+	"register": true,
+	"s":        true,
+	"shop":     true,
+}
+
 const kofiHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
 
 func isNotKofiHandleRune(r rune) bool {
 	return !strings.ContainsRune(kofiHandleAlpha, r)
 }
+
+const kofiIDAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotKofiIDRune(r rune) bool {
+	return !strings.ContainsRune(kofiIDAlpha, r)
+}
@@ -0,0 +1,112 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// LeetCode Profile: ^https://(www\.)?leetcode\.com/(u/)?[A-Za-z0-9_-]{1,30}/?$
+// LeetCode Problem: ^https://(www\.)?leetcode\.com/problems/[a-z0-9-]{1,100}(/description)?/?$
+
+func decodeLeetCodeURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid LeetCode scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "leetcode.com" && url.Host != "www.leetcode.com" {
+		return nil, fmt.Errorf("%w: invalid LeetCode host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid LeetCode path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var username string
+	switch {
+	case (len(parts) == 2 || (len(parts) == 3 && parts[2] == "description")) && parts[0] == "problems":
+		problem := parts[1]
+		if len(problem) < 1 || len(problem) > 100 {
+			return nil, fmt.Errorf("%w: invalid LeetCode problem length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(problem, isNotLeetCodeSlugRune) {
+			return nil, fmt.Errorf("%w: invalid LeetCode problem", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: LeetCode,
+			Type:    "Problem",
+			ID:      problem,
+			Data: map[string]string{
+				"problem": problem,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "u":
+		username = parts[1]
+
+	case len(parts) == 1 && !leetCodeReservedPaths[parts[0]]:
+		username = parts[0]
+
+	default:
+		return nil, fmt.Errorf("%w: invalid LeetCode path", ErrInvalidURL)
+	}
+
+	if len(username) < 1 || len(username) > 30 {
+		return nil, fmt.Errorf("%w: invalid LeetCode username length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(username, isNotLeetCodeUsernameRune) {
+		return nil, fmt.Errorf("%w: invalid LeetCode username", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: LeetCode,
+		Type:    "Profile",
+		ID:      username,
+		Data: map[string]string{
+			"username": username,
+		},
+		URL: url,
+	}, nil
+}
+
+// leetCodeReservedPaths holds the first segments of LeetCode's own routes,
+// like /problemset and /contest. Old profile links are at leetcode.com/{user},
+// without the /u/ of current ones, so any other single segment is a username.
+var leetCodeReservedPaths = map[string]bool{
+	"accounts":   true,
+	"assessment": true,
+	"contest":    true,
+	"discuss":    true,
+	"explore":    true,
+	"interview":  true,
+	"jobs":       true,
+	"problems":   true,
+	"problemset": true,
+	"privacy":    true,
+	"region":     true,
+	"store":      true,
+	"studyplan":  true,
+	"subscribe":  true,
+	"support":    true,
+	"terms":      true,
+	"u":          true,
+}
+
+const leetCodeUsernameAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotLeetCodeUsernameRune(r rune) bool {
+	return !strings.ContainsRune(leetCodeUsernameAlpha, r)
+}
+
+const leetCodeSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotLeetCodeSlugRune(r rune) bool {
+	return !strings.ContainsRune(leetCodeSlugAlpha, r)
+}
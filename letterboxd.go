@@ -7,6 +7,11 @@ import (
 )
 
 // Letterboxd Profile: ^https://(www\.)?letterboxd\.com/[A-Za-z0-9_]{2,15}/?$
+// Letterboxd Film: ^https://(www\.)?letterboxd\.com/film/[a-z0-9-]{1,200}/?$
+// Letterboxd List: ^https://(www\.)?letterboxd\.com/[A-Za-z0-9_]{2,15}/list/[a-z0-9-]{1,200}/?$
+// Letterboxd Review: ^https://(www\.)?letterboxd\.com/[A-Za-z0-9_]{2,15}/film/[a-z0-9-]{1,200}/?$
+// Letterboxd Diary: ^https://(www\.)?letterboxd\.com/[A-Za-z0-9_]{2,15}/films/diary/?$
+// Letterboxd ShortLink: ^https://boxd\.it/[A-Za-z0-9]{1,10}/?$
 
 func decodeLetterboxdURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,7 +21,7 @@ func decodeLetterboxdURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Letterboxd scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "letterboxd.com" && url.Host != "www.letterboxd.com" {
+	if url.Host != "letterboxd.com" && url.Host != "www.letterboxd.com" && url.Host != "boxd.it" {
 		return nil, fmt.Errorf("%w: invalid Letterboxd host", ErrInvalidURL)
 	}
 
@@ -24,8 +29,52 @@ func decodeLetterboxdURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Letterboxd path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	if url.Host == "boxd.it" {
+		if len(parts) != 1 {
+			return nil, fmt.Errorf("%w: invalid Letterboxd path", ErrInvalidURL)
+		}
+
+		code := parts[0]
+		if len(code) < 1 || len(code) > 10 {
+			return nil, fmt.Errorf("%w: invalid Letterboxd short link code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotLetterboxdCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Letterboxd short link code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Letterboxd,
+			Type:    "ShortLink",
+			ID:      code,
+			Data: map[string]string{
+				"code": code,
+			},
+			URL: url,
+		}, nil
+	}
+
+	if parts[0] == "film" {
+		if len(parts) != 2 || !isLetterboxdSlugValid(parts[1]) {
+			return nil, fmt.Errorf("%w: invalid Letterboxd film", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Letterboxd,
+			Type:    "Film",
+			ID:      parts[1],
+			Data: map[string]string{
+				"film": parts[1],
+			},
+			URL: url,
+		}, nil
+	}
+
+	username := parts[0]
+	if letterboxdReservedPaths[username] {
+		return nil, fmt.Errorf("%w: invalid Letterboxd path", ErrInvalidURL)
+	}
 	if len(username) < 2 || len(username) > 15 {
 		return nil, fmt.Errorf("%w: invalid Letterboxd username length", ErrInvalidURL)
 	}
@@ -33,15 +82,98 @@ func decodeLetterboxdURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Letterboxd username", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: Letterboxd,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch {
+	case len(parts) == 1:
+		return &URL{
+			Service: Letterboxd,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[1] == "films" && parts[2] == "diary":
+		return &URL{
+			Service: Letterboxd,
+			Type:    "Diary",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[1] == "list":
+		if !isLetterboxdSlugValid(parts[2]) {
+			return nil, fmt.Errorf("%w: invalid Letterboxd list", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Letterboxd,
+			Type:    "List",
+			ID:      username + "/" + parts[2],
+			Data: map[string]string{
+				"username": username,
+				"list":     parts[2],
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[1] == "film":
+		if !isLetterboxdSlugValid(parts[2]) {
+			return nil, fmt.Errorf("%w: invalid Letterboxd film", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Letterboxd,
+			Type:    "Review",
+			ID:      username + "/" + parts[2],
+			Data: map[string]string{
+				"username": username,
+				"film":     parts[2],
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Letterboxd path", ErrInvalidURL)
+	}
+}
+
+func isLetterboxdSlugValid(slug string) bool {
+	return len(slug) >= 1 && len(slug) <= 200 && !strings.ContainsFunc(slug, isNotLetterboxdSlugRune)
+}
+
+// letterboxdReservedPaths holds the first segments of Letterboxd's catalogue
+// and site routes, like /films, /actor/{name} and /year/{year}. Profiles and
+// the lists, reviews and diaries under them start with a username instead.
+var letterboxdReservedPaths = map[string]bool{
+	"about":          true,
+	"activity":       true,
+	"actor":          true,
+	"apps":           true,
+	"contact":        true,
+	"create-account": true,
+	"crew":           true,
+	"director":       true,
+	"film":           true,
+	"films":          true,
+	"journal":        true,
+	"legal":          true,
+	"list":           true,
+	"lists":          true,
+	"members":        true,
+	"pro":            true,
+	"search":         true,
+	"settings":       true,
+	"showdown":       true,
+	"sign-in":        true,
+	"studio":         true,
+	"tag":            true,
+	"welcome":        true,
+	"year":           true,
 }
 
 const letterboxdHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
@@ -49,3 +181,15 @@ const letterboxdHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvw
 func isNotLetterboxdHandleRune(r rune) bool {
 	return !strings.ContainsRune(letterboxdHandleAlpha, r)
 }
+
+const letterboxdSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotLetterboxdSlugRune(r rune) bool {
+	return !strings.ContainsRune(letterboxdSlugAlpha, r)
+}
+
+const letterboxdCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotLetterboxdCodeRune(r rune) bool {
+	return !strings.ContainsRune(letterboxdCodeAlpha, r)
+}
@@ -0,0 +1,108 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Line Profile: ^https://line\.me/(R/)?ti/p/~[A-Za-z0-9._-]{4,20}/?$
+// Line Profile: ^https://line\.me/(R/)?ti/p/@[A-Za-z0-9._-]{4,20}/?$
+// Line Profile: ^https://line\.me/(R/)?ti/p/[A-Za-z0-9_-]{4,64}/?$
+// Line Group: ^https://line\.me/(R/)?ti/g/[A-Za-z0-9_-]{4,64}/?$
+// Line OpenChat: ^https://line\.me/(R/)?ti/g2/[A-Za-z0-9_-]{4,64}/?$
+//
+// Profiles are given by LINE ID ("~"), by official account ID ("@"), or by
+// an opaque identifier from a QR code.
+
+func decodeLineURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Line scheme", ErrInvalidURL)
+	}
+
+	if url.Host != "line.me" && url.Host != "www.line.me" {
+		return nil, fmt.Errorf("%w: invalid Line host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	path = strings.TrimPrefix(path, "/R")
+	if !strings.HasPrefix(path, "/ti/") {
+		return nil, fmt.Errorf("%w: invalid Line path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/ti/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: invalid Line path", ErrInvalidURL)
+	}
+
+	id := parts[1]
+	switch {
+	case parts[0] == "p" && strings.HasPrefix(id, "~"):
+		return newLineProfileURL(url, "lineID", id)
+	case parts[0] == "p" && strings.HasPrefix(id, "@"):
+		return newLineProfileURL(url, "accountID", id)
+	case parts[0] == "p":
+		return newLineCodeURL(url, "Profile", id)
+	case parts[0] == "g":
+		return newLineCodeURL(url, "Group", id)
+	case parts[0] == "g2":
+		return newLineCodeURL(url, "OpenChat", id)
+	default:
+		return nil, fmt.Errorf("%w: invalid Line path", ErrInvalidURL)
+	}
+}
+
+// newLineProfileURL returns the profile for id, a LINE ID or official account
+// ID with its "~" or "@" prefix, which is stored without it in Data under key.
+func newLineProfileURL(url *url.URL, key, id string) (*URL, error) {
+	value := id[1:]
+	if len(value) < 4 || len(value) > 20 {
+		return nil, fmt.Errorf("%w: invalid Line ID length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(value, isNotLineHandleRune) {
+		return nil, fmt.Errorf("%w: invalid Line ID", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Line,
+		Type:    "Profile",
+		ID:      id,
+		Data: map[string]string{
+			key: value,
+		},
+		URL: url,
+	}, nil
+}
+
+func newLineCodeURL(url *url.URL, typ, code string) (*URL, error) {
+	if len(code) < 4 || len(code) > 64 {
+		return nil, fmt.Errorf("%w: invalid Line code length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(code, isNotLineCodeRune) {
+		return nil, fmt.Errorf("%w: invalid Line code", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Line,
+		Type:    typ,
+		ID:      code,
+		Data: map[string]string{
+			"code": code,
+		},
+		URL: url,
+	}, nil
+}
+
+const lineHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._-"
+
+func isNotLineHandleRune(r rune) bool {
+	return !strings.ContainsRune(lineHandleAlpha, r)
+}
+
+const lineCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotLineCodeRune(r rune) bool {
+	return !strings.ContainsRune(lineCodeAlpha, r)
+}
@@ -0,0 +1,287 @@
+package slinky
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Matrix User: ^https://matrix\.to/#/@[a-z0-9._=/+-]{1,255}:{server}(\?{params})?$
+// Matrix User: ^matrix:u/[a-z0-9._=/+-]{1,255}:{server}(\?{params})?$
+// Matrix Room: ^https://matrix\.to/#/#[^:]{1,255}:{server}(\?{params})?$
+// Matrix Room: ^https://matrix\.to/#/![^:]{1,255}(:{server})?(\?{params})?$
+// Matrix Room: ^matrix:r/[^:]{1,255}:{server}(\?{params})?$
+// Matrix Room: ^matrix:roomid/[^:]{1,255}(:{server})?(\?{params})?$
+// Matrix Event: ^https://matrix\.to/#/{room}/\$[^/]{1,255}(\?{params})?$
+// Matrix Event: ^matrix:(r|roomid)/{room}/e/[^/]{1,255}(\?{params})?$
+//
+// Where {server} is the name of a homeserver: a hostname, IPv4 address or
+// bracketed IPv6 address, optionally followed by a port. Identifiers may be
+// percent-encoded. Of the {params}, only via, the servers to join a room
+// through, is kept.
+
+func decodeMatrixURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+
+	var ids, via []string
+	switch url.Scheme {
+	case "matrix":
+		var ok bool
+		ids, ok = parseMatrixURIPath(url.Opaque)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid Matrix path", ErrInvalidURL)
+		}
+		via = url.Query()["via"]
+
+	case "https":
+		if url.Host != "matrix.to" {
+			return nil, fmt.Errorf("%w: invalid Matrix host", ErrInvalidURL)
+		}
+		if url.Path != "" && url.Path != "/" {
+			return nil, fmt.Errorf("%w: invalid Matrix path", ErrInvalidURL)
+		}
+
+		// matrix.to keeps the identifiers and their parameters in the
+		// fragment, so that they are never sent to the server.
+		fragmentIDs, query, ok := parseMatrixFragment(url.EscapedFragment())
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid Matrix fragment", ErrInvalidURL)
+		}
+		ids, via = fragmentIDs, query["via"]
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Matrix scheme", ErrInvalidURL)
+	}
+
+	return newMatrixURL(url, ids, via)
+}
+
+// parseMatrixURIPath returns the identifiers in path, the opaque part of a
+// Matrix URI like "r/room:example.org/e/event", with their sigils.
+func parseMatrixURIPath(path string) ([]string, bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 && len(parts) != 4 {
+		return nil, false
+	}
+	ids := []string{}
+	for i := 0; i < len(parts); i += 2 {
+		// Matrix URIs name the kind of each identifier instead of prefixing
+		// it with a sigil. Only the second may be an event.
+		sigil, ok := matrixURIKinds[parts[i]]
+		if !ok || (i == 0) == (sigil == '$') {
+			return nil, false
+		}
+		id, err := url.PathUnescape(parts[i+1])
+		if err != nil {
+			return nil, false
+		}
+		ids = append(ids, string(sigil)+id)
+	}
+	return ids, true
+}
+
+// parseMatrixFragment returns the identifiers and parameters in fragment, the
+// escaped fragment of a matrix.to permalink like "/#room:example.org?via=x".
+func parseMatrixFragment(fragment string) ([]string, url.Values, bool) {
+	if !strings.HasPrefix(fragment, "/") {
+		return nil, nil, false
+	}
+	path, rawQuery, _ := strings.Cut(fragment[1:], "?")
+	ids := []string{}
+	for _, part := range strings.Split(path, "/") {
+		id, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, nil, false
+		}
+		ids = append(ids, id)
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, nil, false
+	}
+	return ids, query, true
+}
+
+// matrixURIKinds maps the kinds of identifiers in Matrix URIs to the sigils
+// that prefix them elsewhere.
+var matrixURIKinds = map[string]byte{
+	"u":      '@',
+	"r":      '#',
+	"roomid": '!',
+	"e":      '$',
+}
+
+// newMatrixURL returns the user, room or event that ids refer to: a user ID,
+// room alias or room ID, optionally followed by the ID of an event in that
+// room. The servers in via are joined by commas in Data.
+func newMatrixURL(url *url.URL, ids []string, via []string) (*URL, error) {
+	if len(ids) != 1 && len(ids) != 2 {
+		return nil, fmt.Errorf("%w: invalid Matrix identifier", ErrInvalidURL)
+	}
+
+	for _, server := range via {
+		if !isMatrixServerNameValid(server) {
+			return nil, fmt.Errorf("%w: invalid Matrix via server name", ErrInvalidURL)
+		}
+	}
+
+	id := ids[0]
+	sigil, localpart, server, ok := parseMatrixID(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid Matrix identifier", ErrInvalidURL)
+	}
+
+	data := map[string]string{}
+	switch sigil {
+	case '@':
+		if len(ids) != 1 {
+			return nil, fmt.Errorf("%w: invalid Matrix identifier", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(localpart, isNotMatrixUserLocalpartRune) {
+			return nil, fmt.Errorf("%w: invalid Matrix user ID", ErrInvalidURL)
+		}
+		data["userID"] = id
+		data["localpart"] = localpart
+		data["server"] = server
+
+	case '#':
+		data["roomAlias"] = id
+		data["localpart"] = localpart
+		data["server"] = server
+
+	case '!':
+		// Room IDs are opaque, and since room version 12 no longer name the
+		// server the room was created on.
+		data["roomID"] = id
+		if server != "" {
+			data["server"] = server
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Matrix identifier", ErrInvalidURL)
+	}
+
+	if len(via) > 0 {
+		data["via"] = strings.Join(via, ",")
+	}
+
+	if len(ids) == 1 {
+		typ := "User"
+		if sigil != '@' {
+			typ = "Room"
+		}
+
+		return &URL{
+			Service: Matrix,
+			Type:    typ,
+			ID:      id,
+			Data:    data,
+			URL:     url,
+		}, nil
+	}
+
+	// Event IDs are hashes since room version 3, and no longer name a
+	// server either, so only the sigil and length are checked.
+	eventID := ids[1]
+	if len(eventID) < 2 || len(eventID) > 255 || eventID[0] != '$' {
+		return nil, fmt.Errorf("%w: invalid Matrix event ID", ErrInvalidURL)
+	}
+	delete(data, "localpart")
+	delete(data, "server")
+	data["eventID"] = eventID
+
+	return &URL{
+		Service: Matrix,
+		Type:    "Event",
+		ID:      eventID,
+		Data:    data,
+		URL:     url,
+	}, nil
+}
+
+// parseMatrixID splits id into its sigil, localpart and server name. The
+// server name is required in all but room IDs.
+func parseMatrixID(id string) (sigil byte, localpart, server string, ok bool) {
+	if len(id) < 2 || len(id) > 255 {
+		return 0, "", "", false
+	}
+	sigil = id[0]
+	localpart, server, hasServer := strings.Cut(id[1:], ":")
+	if len(localpart) < 1 {
+		return 0, "", "", false
+	}
+	if !hasServer {
+		return sigil, localpart, "", sigil == '!'
+	}
+	if !isMatrixServerNameValid(server) {
+		return 0, "", "", false
+	}
+	return sigil, localpart, server, true
+}
+
+// isMatrixServerNameValid reports whether name is a Matrix server name: a
+// domain name, single-label hostname like localhost, IPv4 address or IPv6
+// address in brackets, optionally followed by a colon and port.
+func isMatrixServerNameValid(name string) bool {
+	host, port, hasPort := name, "", false
+	if strings.HasPrefix(name, "[") {
+		i := strings.IndexByte(name, ']')
+		if i < 0 {
+			return false
+		}
+		host = name[1:i]
+		if rest := name[i+1:]; rest != "" {
+			if rest[0] != ':' {
+				return false
+			}
+			port, hasPort = rest[1:], true
+		}
+		addr, err := netip.ParseAddr(host)
+		if err != nil || !addr.Is6() || addr.Zone() != "" {
+			return false
+		}
+	} else {
+		if i := strings.LastIndexByte(name, ':'); i >= 0 {
+			host, port, hasPort = name[:i], name[i+1:], true
+		}
+		if addr, err := netip.ParseAddr(host); err == nil {
+			if !addr.Is4() {
+				return false
+			}
+		} else if !isDomainNameValid(host) && !isMatrixHostnameLabelValid(host) {
+			return false
+		}
+	}
+
+	if hasPort {
+		if len(port) < 1 || len(port) > 5 || strings.ContainsFunc(port, isNotMatrixPortRune) {
+			return false
+		}
+		if n, _ := strconv.Atoi(port); n < 1 || n > 65535 {
+			return false
+		}
+	}
+	return true
+}
+
+// isMatrixHostnameLabelValid reports whether host is a single-label hostname
+// that cannot be mistaken for a partial IPv4 address.
+func isMatrixHostnameLabelValid(host string) bool {
+	return isDomainLabelValid(host) && (host[0] < '0' || host[0] > '9')
+}
+
+const matrixUserLocalpartAlpha = "abcdefghijklmnopqrstuvwxyz0123456789._=/+-"
+
+func isNotMatrixUserLocalpartRune(r rune) bool {
+	return !strings.ContainsRune(matrixUserLocalpartAlpha, r)
+}
+
+const matrixPortAlpha = "0123456789"
+
+func isNotMatrixPortRune(r rune) bool {
+	return !strings.ContainsRune(matrixPortAlpha, r)
+}
@@ -7,6 +7,14 @@ import (
 )
 
 // Medium Profile: ^https://(www\.)?medium\.com/@[A-Za-z0-9._]{1,30}/?$
+// Medium Profile: ^https://[a-z0-9-]{1,30}\.medium\.com/?$
+// Medium Publication: ^https://(www\.)?medium\.com/[a-z0-9-]{1,60}/?$
+// Medium Story: ^https://(www\.)?medium\.com/@[A-Za-z0-9._]{1,30}/([A-Za-z0-9-]+-)?[0-9a-f]{12}/?$
+// Medium Story: ^https://(www\.)?medium\.com/[a-z0-9-]{1,60}/([A-Za-z0-9-]+-)?[0-9a-f]{12}/?$
+// Medium Story: ^https://[a-z0-9-]{1,30}\.medium\.com/([A-Za-z0-9-]+-)?[0-9a-f]{12}/?$
+// Medium Story: ^https://(www\.)?medium\.com/p/[0-9a-f]{12}/?$
+//
+// Subdomains spell the dots and underscores of usernames as hyphens.
 
 func decodeMediumURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,36 +24,169 @@ func decodeMediumURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Medium scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "medium.com" && url.Host != "www.medium.com" {
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) > 0 && path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Medium path", ErrInvalidURL)
+	}
+	var parts []string
+	if path != "" {
+		parts = strings.Split(strings.TrimPrefix(path, "/"), "/")
+	}
+
+	switch {
+	case url.Host == "medium.com" || url.Host == "www.medium.com":
+		switch {
+		case len(parts) == 2 && parts[0] == "p":
+			return newMediumStoryURL(url, parts[1], map[string]string{})
+
+		case len(parts) >= 1 && strings.HasPrefix(parts[0], "@"):
+			username := strings.TrimPrefix(parts[0], "@")
+			if !isMediumUsernameValid(username) {
+				return nil, fmt.Errorf("%w: invalid Medium username", ErrInvalidURL)
+			}
+			return newMediumOwnerURL(url, parts[1:], "username", username)
+
+		case len(parts) >= 1:
+			publication := parts[0]
+			if mediumReservedPaths[publication] {
+				return nil, fmt.Errorf("%w: invalid Medium path", ErrInvalidURL)
+			}
+			if len(publication) < 1 || len(publication) > 60 {
+				return nil, fmt.Errorf("%w: invalid Medium publication length", ErrInvalidURL)
+			}
+			if strings.ContainsFunc(publication, isNotMediumPublicationRune) {
+				return nil, fmt.Errorf("%w: invalid Medium publication", ErrInvalidURL)
+			}
+			return newMediumOwnerURL(url, parts[1:], "publication", publication)
+
+		default:
+			return nil, fmt.Errorf("%w: invalid Medium path", ErrInvalidURL)
+		}
+
+	case strings.HasSuffix(url.Host, ".medium.com"):
+		username := strings.TrimSuffix(url.Host, ".medium.com")
+		if !isMediumSubdomainValid(username) || mediumReservedSubdomains[username] {
+			return nil, fmt.Errorf("%w: invalid Medium username", ErrInvalidURL)
+		}
+		return newMediumOwnerURL(url, parts, "username", username)
+
+	default:
 		return nil, fmt.Errorf("%w: invalid Medium host", ErrInvalidURL)
 	}
+}
 
-	path := strings.TrimSuffix(url.Path, "/")
-	if !strings.HasPrefix(path, "/@") {
+// newMediumOwnerURL returns the profile or publication identified by key and
+// value, or one of its stories if rest holds a story path segment.
+func newMediumOwnerURL(url *url.URL, rest []string, key, value string) (*URL, error) {
+	switch len(rest) {
+	case 0:
+		typ := "Profile"
+		if key == "publication" {
+			typ = "Publication"
+		}
+
+		return &URL{
+			Service: Medium,
+			Type:    typ,
+			ID:      value,
+			Data: map[string]string{
+				key: value,
+			},
+			URL: url,
+		}, nil
+
+	case 1:
+		return newMediumStoryURL(url, rest[0], map[string]string{
+			key: value,
+		})
+
+	default:
 		return nil, fmt.Errorf("%w: invalid Medium path", ErrInvalidURL)
 	}
+}
 
-	username := strings.TrimPrefix(path, "/@")
-	if len(username) < 1 || len(username) > 30 {
-		return nil, fmt.Errorf("%w: invalid Medium username length", ErrInvalidURL)
+// newMediumStoryURL returns the story identified by s, a post ID optionally
+// preceded by a hyphenated slug of the story's title.
+func newMediumStoryURL(url *url.URL, s string, data map[string]string) (*URL, error) {
+	slug, postID := "", s
+	if i := strings.LastIndexByte(s, '-'); i >= 0 {
+		slug, postID = s[:i], s[i+1:]
+	}
+	if len(postID) != 12 || strings.ContainsFunc(postID, isNotMediumPostIDRune) {
+		return nil, fmt.Errorf("%w: invalid Medium post ID", ErrInvalidURL)
 	}
-	if strings.ContainsFunc(username, isNotMediumHandleRune) {
-		return nil, fmt.Errorf("%w: invalid Medium username", ErrInvalidURL)
+	if slug != "" {
+		data["slug"] = slug
 	}
+	data["postID"] = postID
 
 	return &URL{
 		Service: Medium,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
+		Type:    "Story",
+		ID:      postID,
+		Data:    data,
+		URL:     url,
 	}, nil
 }
 
+func isMediumUsernameValid(username string) bool {
+	return len(username) >= 1 && len(username) <= 30 && !strings.ContainsFunc(username, isNotMediumHandleRune)
+}
+
+func isMediumSubdomainValid(subdomain string) bool {
+	return len(subdomain) >= 1 && len(subdomain) <= 30 && !strings.ContainsFunc(subdomain, isNotMediumSubdomainRune)
+}
+
+// mediumReservedPaths holds the paths of medium.com that are not publication
+// slugs. Profiles are told apart by the "@" before their handles, but a
+// publication may be at any other /{slug}, so Medium's own pages and the "p"
+// and "m" prefixes of its story and redirect links are listed here.
+var mediumReservedPaths = map[string]bool{
+	"about":          true,
+	"creators":       true,
+	"jobs-at-medium": true,
+	"m":              true,
+	"me":             true,
+	"membership":     true,
+	"new-story":      true,
+	"p":              true,
+	"plans":          true,
+	"policy":         true,
+	"search":         true,
+	"tag":            true,
+	"topics":         true,
+}
+
+// mediumReservedSubdomains holds the subdomains Medium serves its API, images
+// (miro), short links and help and policy sites from.
+var mediumReservedSubdomains = map[string]bool{
+	"api":    true,
+	"help":   true,
+	"link":   true,
+	"miro":   true,
+	"policy": true,
+}
+
 const mediumHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._"
 
 func isNotMediumHandleRune(r rune) bool {
 	return !strings.ContainsRune(mediumHandleAlpha, r)
 }
+
+const mediumSubdomainAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotMediumSubdomainRune(r rune) bool {
+	return !strings.ContainsRune(mediumSubdomainAlpha, r)
+}
+
+const mediumPublicationAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotMediumPublicationRune(r rune) bool {
+	return !strings.ContainsRune(mediumPublicationAlpha, r)
+}
+
+const mediumPostIDAlpha = "0123456789abcdef"
+
+func isNotMediumPostIDRune(r rune) bool {
+	return !strings.ContainsRune(mediumPostIDAlpha, r)
+}
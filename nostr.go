@@ -0,0 +1,229 @@
+package slinky
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Nostr Profile: ^https://(njump\.me|iris\.to|nostr\.band|nostr\.com)/{profile}/?$
+// Nostr Profile: ^https://(primal\.net|snort\.social)/p/{profile}/?$
+// Nostr Profile: ^https://snort\.social/{profile}/?$
+// Nostr Profile: ^https://coracle\.social/people/{profile}/?$
+// Nostr Profile: ^nostr:{profile}$
+// Nostr Note: ^https://(njump\.me|iris\.to|nostr\.band|nostr\.com)/{note}/?$
+// Nostr Note: ^https://(primal\.net|snort\.social)/e/{note}/?$
+// Nostr Note: ^https://snort\.social/{note}/?$
+// Nostr Note: ^https://coracle\.social/notes/{note}/?$
+// Nostr Note: ^nostr:{note}$
+//
+// Where {profile} is a bech32 encoded npub1 or nprofile1 entity and {note} is a
+// note1 or nevent1 entity, as defined in NIP-19. The ID of a profile is its
+// public key in hex and that of a note its event ID in hex, so links to the
+// same key or event have the same ID however they are encoded.
+
+// nostrClientPaths lists, for each web client host, the path prefixes that
+// Nostr entities follow, and the type of the entities each prefix allows. An
+// empty prefix means the entity is the whole path, and an empty type that any
+// entity is allowed.
+var nostrClientPaths = map[string]map[string]string{
+	"njump.me":       {"": ""},
+	"iris.to":        {"": ""},
+	"nostr.band":     {"": ""},
+	"nostr.com":      {"": ""},
+	"primal.net":     {"p": "Profile", "e": "Note"},
+	"snort.social":   {"": "", "p": "Profile", "e": "Note"},
+	"coracle.social": {"people": "Profile", "notes": "Note"},
+}
+
+// nostrEntityMaxLength bounds the length of the bech32 entities accepted. NIP-19
+// lifts the 90 character limit of BIP 173, as relay hints make TLV entities
+// longer, but no real entity comes close to this.
+const nostrEntityMaxLength = 5000
+
+func decodeNostrURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+
+	var entity, typ string
+	switch url.Scheme {
+	case "nostr":
+		entity = url.Opaque
+
+	case "https":
+		prefixes, ok := nostrClientPaths[url.Host]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid Nostr host", ErrInvalidURL)
+		}
+
+		path := strings.TrimSuffix(url.Path, "/")
+		if len(path) < 1 || path[0] != '/' {
+			return nil, fmt.Errorf("%w: invalid Nostr path", ErrInvalidURL)
+		}
+		parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+		if len(parts) > 2 {
+			return nil, fmt.Errorf("%w: invalid Nostr path", ErrInvalidURL)
+		}
+		prefix := ""
+		if len(parts) == 2 {
+			prefix = parts[0]
+		}
+		typ, ok = prefixes[prefix]
+		entity = parts[len(parts)-1]
+		if !ok || entity == "" {
+			return nil, fmt.Errorf("%w: invalid Nostr path", ErrInvalidURL)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Nostr scheme", ErrInvalidURL)
+	}
+
+	u, err := newNostrEntityURL(url, entity)
+	if err != nil {
+		return nil, err
+	}
+	if typ != "" && u.Type != typ {
+		return nil, fmt.Errorf("%w: invalid Nostr path for entity", ErrInvalidURL)
+	}
+	return u, nil
+}
+
+// newNostrEntityURL returns the profile or note that entity, a bech32 encoded
+// NIP-19 entity, refers to.
+func newNostrEntityURL(url *url.URL, entity string) (*URL, error) {
+	if len(entity) > nostrEntityMaxLength {
+		return nil, fmt.Errorf("%w: invalid Nostr identifier length", ErrInvalidURL)
+	}
+	hrp, data, ok := decodeBech32(entity)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid Nostr identifier", ErrInvalidURL)
+	}
+
+	switch hrp {
+	case "npub":
+		if len(data) != 32 {
+			return nil, fmt.Errorf("%w: invalid Nostr public key", ErrInvalidURL)
+		}
+		return newNostrProfileURL(url, data, nil), nil
+
+	case "nprofile":
+		tlv, ok := decodeNostrTLV(data)
+		if !ok || len(tlv.special) != 32 {
+			return nil, fmt.Errorf("%w: invalid Nostr profile", ErrInvalidURL)
+		}
+		return newNostrProfileURL(url, tlv.special, tlv.relays), nil
+
+	case "note":
+		if len(data) != 32 {
+			return nil, fmt.Errorf("%w: invalid Nostr event ID", ErrInvalidURL)
+		}
+		return newNostrNoteURL(url, data, nostrTLV{}), nil
+
+	case "nevent":
+		tlv, ok := decodeNostrTLV(data)
+		if !ok || len(tlv.special) != 32 || (tlv.author != nil && len(tlv.author) != 32) {
+			return nil, fmt.Errorf("%w: invalid Nostr event", ErrInvalidURL)
+		}
+		return newNostrNoteURL(url, tlv.special, tlv), nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Nostr identifier", ErrInvalidURL)
+	}
+}
+
+// newNostrProfileURL returns the profile of pubkey, with its relay hints
+// joined by commas in Data.
+func newNostrProfileURL(url *url.URL, pubkey []byte, relays []string) *URL {
+	data := map[string]string{
+		"pubkey": hex.EncodeToString(pubkey),
+		"npub":   encodeBech32("npub", pubkey),
+	}
+	if len(relays) > 0 {
+		data["relays"] = strings.Join(relays, ",")
+	}
+
+	return &URL{
+		Service: Nostr,
+		Type:    "Profile",
+		ID:      data["pubkey"],
+		Data:    data,
+		URL:     url,
+	}
+}
+
+// newNostrNoteURL returns the note with eventID, along with the relay hints,
+// author and kind from tlv when present.
+func newNostrNoteURL(url *url.URL, eventID []byte, tlv nostrTLV) *URL {
+	data := map[string]string{
+		"eventID": hex.EncodeToString(eventID),
+		"note":    encodeBech32("note", eventID),
+	}
+	if len(tlv.relays) > 0 {
+		data["relays"] = strings.Join(tlv.relays, ",")
+	}
+	if tlv.author != nil {
+		data["author"] = hex.EncodeToString(tlv.author)
+	}
+	if tlv.kind != nil {
+		data["kind"] = strconv.FormatUint(uint64(*tlv.kind), 10)
+	}
+
+	return &URL{
+		Service: Nostr,
+		Type:    "Note",
+		ID:      data["eventID"],
+		Data:    data,
+		URL:     url,
+	}
+}
+
+// A nostrTLV holds the fields of the TLV data in nprofile and nevent entities.
+type nostrTLV struct {
+	special []byte
+	relays  []string
+	author  []byte
+	kind    *uint32
+}
+
+// decodeNostrTLV decodes b, a sequence of type, length and value records. It
+// returns false if a record is truncated, or if the special field is missing
+// or repeated. Records of unknown types are skipped, as NIP-19 requires.
+func decodeNostrTLV(b []byte) (nostrTLV, bool) {
+	var tlv nostrTLV
+	for len(b) > 0 {
+		if len(b) < 2 || len(b) < 2+int(b[1]) {
+			return nostrTLV{}, false
+		}
+		typ, value := b[0], b[2:2+int(b[1])]
+		b = b[2+int(b[1]):]
+
+		switch typ {
+		case 0:
+			if tlv.special != nil {
+				return nostrTLV{}, false
+			}
+			tlv.special = value
+		case 1:
+			if len(value) > 0 {
+				tlv.relays = append(tlv.relays, string(value))
+			}
+		case 2:
+			tlv.author = value
+		case 3:
+			if len(value) != 4 {
+				return nostrTLV{}, false
+			}
+			kind := binary.BigEndian.Uint32(value)
+			tlv.kind = &kind
+		}
+	}
+	if tlv.special == nil {
+		return nostrTLV{}, false
+	}
+	return tlv, true
+}
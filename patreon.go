@@ -6,7 +6,12 @@ import (
 	"strings"
 )
 
-// Patreon Profile: ^https://(www\.)?patreon\.com/[A-Za-z0-9_]{1,64}/?$
+// Patreon Profile: ^https://(www\.)?patreon\.com/(c/)?[A-Za-z0-9_]{1,64}/?$
+// Patreon Profile: ^https://(www\.)?patreon\.com/user/?\?u=[0-9]{1,20}$
+// Patreon Post: ^https://(www\.)?patreon\.com/posts/([A-Za-z0-9-]+-)?[0-9]{1,20}/?$
+// Patreon Join: ^https://(www\.)?patreon\.com/join/[A-Za-z0-9_]{1,64}/?$
+// Patreon Join: ^https://(www\.)?patreon\.com/bePatron\?c=[0-9]{1,20}$
+// Patreon Checkout: ^https://(www\.)?patreon\.com/checkout/[A-Za-z0-9_]{1,64}/?(\?rid=[0-9]{1,20})?$
 
 func decodePatreonURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -24,8 +29,96 @@ func decodePatreonURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Patreon path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "user":
+		userID := url.Query().Get("u")
+		if !isPatreonIDValid(userID) {
+			return nil, fmt.Errorf("%w: invalid Patreon user ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Patreon,
+			Type:    "Profile",
+			ID:      userID,
+			Data: map[string]string{
+				"userID": userID,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 1 && parts[0] == "bePatron":
+		campaignID := url.Query().Get("c")
+		if !isPatreonIDValid(campaignID) {
+			return nil, fmt.Errorf("%w: invalid Patreon campaign ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Patreon,
+			Type:    "Join",
+			ID:      campaignID,
+			Data: map[string]string{
+				"campaignID": campaignID,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "posts":
+		slug, postID := "", parts[1]
+		if i := strings.LastIndexByte(postID, '-'); i >= 0 {
+			slug, postID = postID[:i], postID[i+1:]
+		}
+		if !isPatreonIDValid(postID) {
+			return nil, fmt.Errorf("%w: invalid Patreon post ID", ErrInvalidURL)
+		}
+
+		data := map[string]string{
+			"postID": postID,
+		}
+		if slug != "" {
+			data["slug"] = slug
+		}
+
+		return &URL{
+			Service: Patreon,
+			Type:    "Post",
+			ID:      postID,
+			Data:    data,
+			URL:     url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "c":
+		return newPatreonCreatorURL(url, "Profile", parts[1])
+
+	case len(parts) == 2 && parts[0] == "join":
+		return newPatreonCreatorURL(url, "Join", parts[1])
+
+	case len(parts) == 2 && parts[0] == "checkout":
+		u, err := newPatreonCreatorURL(url, "Checkout", parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if url.Query().Has("rid") {
+			rewardID := url.Query().Get("rid")
+			if !isPatreonIDValid(rewardID) {
+				return nil, fmt.Errorf("%w: invalid Patreon reward ID", ErrInvalidURL)
+			}
+			u.Data["rewardID"] = rewardID
+		}
+		return u, nil
+
+	case len(parts) == 1 && !patreonReservedPaths[parts[0]]:
+		return newPatreonCreatorURL(url, "Profile", parts[0])
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Patreon path", ErrInvalidURL)
+	}
+}
+
+// newPatreonCreatorURL returns the URL of the given type for a page of the
+// creator with the given vanity name.
+func newPatreonCreatorURL(url *url.URL, typ, username string) (*URL, error) {
 	if len(username) < 1 || len(username) > 64 {
 		return nil, fmt.Errorf("%w: invalid Patreon username length", ErrInvalidURL)
 	}
@@ -35,7 +128,7 @@ func decodePatreonURL(url *url.URL) (*URL, error) {
 
 	return &URL{
 		Service: Patreon,
-		Type:    "Profile",
+		Type:    typ,
 		ID:      username,
 		Data: map[string]string{
 			"username": username,
@@ -44,8 +137,43 @@ func decodePatreonURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+func isPatreonIDValid(id string) bool {
+	return len(id) >= 1 && len(id) <= 20 && !strings.ContainsFunc(id, isNotPatreonIDRune)
+}
+
+// patreonReservedPaths holds Patreon's own pages and the prefixes of the
+// routes decoded on their own, like /c/{name}, /join/{name} and /posts/{id}.
+var patreonReservedPaths = map[string]bool{
+	"about":         true,
+	"apps":          true,
+	"bePatron":      true,
+	"c":             true,
+	"checkout":      true,
+	"collection":    true,
+	"create":        true,
+	"explore":       true,
+	"home":          true,
+	"join":          true,
+	"login":         true,
+	"messages":      true,
+	"notifications": true,
+	"policy":        true,
+	"posts":         true,
+	"pricing":       true,
+	"search":        true,
+	"settings":      true,
+	"signup":        true,
+	"user":          true,
+}
+
 const patreonHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
 
 func isNotPatreonHandleRune(r rune) bool {
 	return !strings.ContainsRune(patreonHandleAlpha, r)
 }
+
+const patreonIDAlpha = "0123456789"
+
+func isNotPatreonIDRune(r rune) bool {
+	return !strings.ContainsRune(patreonIDAlpha, r)
+}
@@ -6,7 +6,10 @@ import (
 	"strings"
 )
 
-// Pinterest Profile: ^https://www\.pinterest.com/u/[A-Za-z0-9_]{3,30}/?$
+// Pinterest Profile: ^https://([a-z]{2}\.|www\.)?pinterest\.(com|[a-z.]+)/[A-Za-z0-9._]{3,30}/?$
+// Pinterest Board: ^https://([a-z]{2}\.|www\.)?pinterest\.(com|[a-z.]+)/[A-Za-z0-9._]{3,30}/[A-Za-z0-9_-]{1,100}/?$
+// Pinterest Pin: ^https://([a-z]{2}\.|www\.)?pinterest\.(com|[a-z.]+)/pin/[0-9]{1,20}/?$
+// Pinterest ShortLink: ^https://pin\.it/[A-Za-z0-9]{1,16}/?$
 
 func decodePinterestURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,16 +19,68 @@ func decodePinterestURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Pinterest scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "pinterest.com" && url.Host != "www.pinterest.com" {
+	if url.Host != "pin.it" && !isPinterestHost(url.Host) {
 		return nil, fmt.Errorf("%w: invalid Pinterest host", ErrInvalidURL)
 	}
 
 	path := strings.TrimSuffix(url.Path, "/")
 	if len(path) < 1 || path[0] != '/' {
-		return nil, fmt.Errorf("%w: invalid pinterest path", ErrInvalidURL)
+		return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	if url.Host == "pin.it" {
+		if len(parts) != 1 {
+			return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
+		}
+
+		code := parts[0]
+		if len(code) < 1 || len(code) > 16 {
+			return nil, fmt.Errorf("%w: invalid Pinterest short link code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotPinterestCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Pinterest short link code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Pinterest,
+			Type:    "ShortLink",
+			ID:      code,
+			Data: map[string]string{
+				"code": code,
+			},
+			URL: url,
+		}, nil
+	}
+
+	if parts[0] == "pin" {
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
+		}
+
+		pinID := parts[1]
+		if len(pinID) < 1 || len(pinID) > 20 {
+			return nil, fmt.Errorf("%w: invalid Pinterest pin ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(pinID, isNotPinterestIDRune) {
+			return nil, fmt.Errorf("%w: invalid Pinterest pin ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Pinterest,
+			Type:    "Pin",
+			ID:      pinID,
+			Data: map[string]string{
+				"pinID": pinID,
+			},
+			URL: url,
+		}, nil
+	}
+
+	username := parts[0]
+	if pinterestReservedPaths[username] {
+		return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
+	}
 	if len(username) < 3 || len(username) > 30 {
 		return nil, fmt.Errorf("%w: invalid Pinterest username length", ErrInvalidURL)
 	}
@@ -33,19 +88,147 @@ func decodePinterestURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Pinterest username", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: Pinterest,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch len(parts) {
+	case 1:
+		return &URL{
+			Service: Pinterest,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case 2:
+		board := parts[1]
+		if strings.HasPrefix(board, "_") || pinterestReservedUserPaths[board] {
+			return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
+		}
+		if len(board) < 1 || len(board) > 100 {
+			return nil, fmt.Errorf("%w: invalid Pinterest board length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(board, isNotPinterestBoardRune) {
+			return nil, fmt.Errorf("%w: invalid Pinterest board", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Pinterest,
+			Type:    "Board",
+			ID:      username + "/" + board,
+			Data: map[string]string{
+				"username": username,
+				"board":    board,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Pinterest path", ErrInvalidURL)
+	}
+}
+
+// isPinterestHost reports whether host is pinterest.com, one of its localized
+// domains, or a country subdomain of either, like in.pinterest.com.
+func isPinterestHost(host string) bool {
+	if pinterestDomains[host] {
+		return true
+	}
+	sub, domain, ok := strings.Cut(host, ".")
+	if !ok || !pinterestDomains[domain] {
+		return false
+	}
+	return sub == "www" || (len(sub) == 2 && !strings.ContainsFunc(sub, isNotPinterestCountryRune))
+}
+
+// pinterestDomains holds the domains Pinterest serves its site on. Each is
+// registered along with a wildcard pattern for its subdomains, which the public
+// suffix list keeps from spanning suffixes like co.uk.
+var pinterestDomains = map[string]bool{
+	"pinterest.at":     true,
+	"pinterest.ca":     true,
+	"pinterest.ch":     true,
+	"pinterest.cl":     true,
+	"pinterest.co.kr":  true,
+	"pinterest.co.uk":  true,
+	"pinterest.com":    true,
+	"pinterest.com.au": true,
+	"pinterest.com.mx": true,
+	"pinterest.de":     true,
+	"pinterest.dk":     true,
+	"pinterest.es":     true,
+	"pinterest.fr":     true,
+	"pinterest.ie":     true,
+	"pinterest.it":     true,
+	"pinterest.jp":     true,
+	"pinterest.nz":     true,
+	"pinterest.ph":     true,
+	"pinterest.pt":     true,
+	"pinterest.se":     true,
+}
+
+func init() {
+	for domain := range pinterestDomains {
+		decodeURLFuncs[domain] = decodePinterestURL
+		decodeURLFuncs["*."+domain] = decodePinterestURL
+	}
+}
+
+// pinterestReservedPaths holds the sections of Pinterest, like /today and
+// /ideas, whose names are also valid usernames.
+var pinterestReservedPaths = map[string]bool{
+	"about":      true,
+	"business":   true,
+	"categories": true,
+	"explore":    true,
+	"ideas":      true,
+	"login":      true,
+	"search":     true,
+	"settings":   true,
+	"shopping":   true,
+	"signup":     true,
+	"today":      true,
+	"topics":     true,
+	"videos":     true,
 }
 
-const pinterestHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789."
+// pinterestReservedUserPaths holds the older profile tabs, like
+// /{user}/pins, that share the /{user}/{board} depth with board slugs. The
+// current tabs, like _saved and _created, are rejected by their leading
+// underscore instead.
+var pinterestReservedUserPaths = map[string]bool{
+	"boards":    true,
+	"followers": true,
+	"following": true,
+	"pins":      true,
+}
+
+const pinterestHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._"
 
 func isNotPinterestHandleRune(r rune) bool {
 	return !strings.ContainsRune(pinterestHandleAlpha, r)
 }
+
+const pinterestBoardAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotPinterestBoardRune(r rune) bool {
+	return !strings.ContainsRune(pinterestBoardAlpha, r)
+}
+
+const pinterestIDAlpha = "0123456789"
+
+func isNotPinterestIDRune(r rune) bool {
+	return !strings.ContainsRune(pinterestIDAlpha, r)
+}
+
+const pinterestCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotPinterestCodeRune(r rune) bool {
+	return !strings.ContainsRune(pinterestCodeAlpha, r)
+}
+
+const pinterestCountryAlpha = "abcdefghijklmnopqrstuvwxyz"
+
+func isNotPinterestCountryRune(r rune) bool {
+	return !strings.ContainsRune(pinterestCountryAlpha, r)
+}
@@ -0,0 +1,75 @@
+package slinky
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed publicsuffix.dat
+var publicSuffixList string
+
+// publicSuffixRules holds the rules of the embedded public suffix list, keyed
+// by suffix in ASCII form. Wildcard rules like "*.ck" are keyed by the suffix
+// after the wildcard, and exception rules like "!www.ck" by the suffix after
+// the "!".
+var publicSuffixRules, publicSuffixWildcardRules, publicSuffixExceptionRules = parsePublicSuffixList(publicSuffixList)
+
+func parsePublicSuffixList(list string) (rules, wildcardRules, exceptionRules map[string]bool) {
+	rules = map[string]bool{}
+	wildcardRules = map[string]bool{}
+	exceptionRules = map[string]bool{}
+	for _, line := range strings.Split(list, "\n") {
+		// Rules end at the first whitespace, as the list's format specifies.
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "//") {
+			continue
+		}
+		rule := fields[0]
+
+		set := rules
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			set, rule = exceptionRules, rule[1:]
+		case strings.HasPrefix(rule, "*."):
+			set, rule = wildcardRules, rule[2:]
+		}
+		// The list spells internationalized suffixes in Unicode, while hosts
+		// are matched in Punycode.
+		if name, ok := toASCIIDomain(rule); ok {
+			set[name] = true
+		}
+	}
+	return rules, wildcardRules, exceptionRules
+}
+
+// publicSuffix returns the public suffix of host, a lowercase domain name in
+// ASCII form, following the matching algorithm of the Public Suffix List. The
+// top-level domain is the public suffix of hosts no rule matches.
+func publicSuffix(host string) string {
+	labels := strings.Split(host, ".")
+	for i := range labels {
+		name := strings.Join(labels[i:], ".")
+		if publicSuffixExceptionRules[name] {
+			return strings.Join(labels[i+1:], ".")
+		}
+		if publicSuffixRules[name] {
+			return name
+		}
+		if i+1 < len(labels) && publicSuffixWildcardRules[strings.Join(labels[i+1:], ".")] {
+			return name
+		}
+	}
+	return labels[len(labels)-1]
+}
+
+// registrableDomain returns the public suffix of host plus the one label
+// before it, like "example.co.uk" for "www.example.co.uk". It returns false if
+// host is itself a public suffix.
+func registrableDomain(host string) (string, bool) {
+	suffix := publicSuffix(host)
+	if host == suffix {
+		return "", false
+	}
+	rest := strings.TrimSuffix(host, "."+suffix)
+	return rest[strings.LastIndexByte(rest, '.')+1:] + "." + suffix, true
+}
@@ -0,0 +1,105 @@
+package slinky
+
+import "strings"
+
+// Punycode parameters, as defined in RFC 3492.
+const (
+	punycodeBase        = 36
+	punycodeTMin        = 1
+	punycodeTMax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+	punycodeMaxDelta    = 1<<31 - 1
+)
+
+// encodePunycode encodes s using the Punycode algorithm from RFC 3492. It
+// reports false if s is too long to be encoded.
+func encodePunycode(s string) (string, bool) {
+	runes := []rune(s)
+
+	var b strings.Builder
+	for _, r := range runes {
+		if r < 0x80 {
+			b.WriteRune(r)
+		}
+	}
+	basic := b.Len()
+	if basic > 0 {
+		b.WriteByte('-')
+	}
+
+	n, delta, bias := rune(punycodeInitialN), 0, punycodeInitialBias
+	for handled := basic; handled < len(runes); {
+		m := rune(0x7fffffff)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if int(m-n) > (punycodeMaxDelta-delta)/(handled+1) {
+			return "", false
+		}
+		delta += int(m-n) * (handled + 1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+				if delta > punycodeMaxDelta {
+					return "", false
+				}
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := k - bias
+				if t < punycodeTMin {
+					t = punycodeTMin
+				} else if t > punycodeTMax {
+					t = punycodeTMax
+				}
+				if q < t {
+					break
+				}
+				b.WriteByte(punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			b.WriteByte(punycodeDigit(q))
+
+			bias = adaptPunycodeBias(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return b.String(), true
+}
+
+func adaptPunycodeBias(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+
+	k := 0
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
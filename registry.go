@@ -1,6 +1,9 @@
 package slinky
 
-import "net/url"
+import (
+	"net/url"
+	"sync"
+)
 
 type decodeURLFunc func(*url.URL) (*URL, error)
 
@@ -13,8 +16,14 @@ var (
 		"m.facebook.com":     decodeFacebookURL,
 		"fb.me":              decodeFacebookURL,
 
+		// AtCoder
+		"atcoder.jp":     decodeAtCoderURL,
+		"www.atcoder.jp": decodeAtCoderURL,
+
 		// Bandcamp
-		"*.bandcamp.com": decodeBandcampURL,
+		"bandcamp.com":     decodeBandcampURL,
+		"www.bandcamp.com": decodeBandcampURL,
+		"*.bandcamp.com":   decodeBandcampURL,
 
 		// Behance
 		"behance.net":     decodeBehanceURL,
@@ -29,9 +38,27 @@ var (
 		// Codeberg
 		"codeberg.org": decodeCodebergURL,
 
+		// Codeforces
+		"codeforces.com":     decodeCodeforcesURL,
+		"www.codeforces.com": decodeCodeforcesURL,
+
 		// DeviantArt
 		"deviantart.com":     decodeDeviantArtURL,
 		"www.deviantart.com": decodeDeviantArtURL,
+		"*.deviantart.com":   decodeDeviantArtURL,
+
+		// DEV
+		"dev.to":     decodeDevToURL,
+		"www.dev.to": decodeDevToURL,
+
+		// Discord
+		"discord.gg":         decodeDiscordURL,
+		"discord.com":        decodeDiscordURL,
+		"www.discord.com":    decodeDiscordURL,
+		"ptb.discord.com":    decodeDiscordURL,
+		"canary.discord.com": decodeDiscordURL,
+		"discordapp.com":     decodeDiscordURL,
+		"www.discordapp.com": decodeDiscordURL,
 
 		// Dribbble
 		"dribbble.com":     decodeDribbbleURL,
@@ -58,6 +85,30 @@ var (
 		"goodreads.com":     decodeGoodreadsURL,
 		"www.goodreads.com": decodeGoodreadsURL,
 
+		// Hacker News
+		"news.ycombinator.com": decodeHackerNewsURL,
+
+		// HackerRank
+		"hackerrank.com":     decodeHackerRankURL,
+		"www.hackerrank.com": decodeHackerRankURL,
+
+		// Hashnode
+		"hashnode.com":     decodeHashnodeURL,
+		"www.hashnode.com": decodeHashnodeURL,
+		"*.hashnode.dev":   decodeHashnodeURL,
+
+		// Kaggle
+		"kaggle.com":     decodeKaggleURL,
+		"www.kaggle.com": decodeKaggleURL,
+
+		// KakaoTalk
+		"open.kakao.com": decodeKakaoTalkURL,
+		"pf.kakao.com":   decodeKakaoTalkURL,
+
+		// Keybase
+		"keybase.io":     decodeKeybaseURL,
+		"www.keybase.io": decodeKeybaseURL,
+
 		// Kick
 		"kick.com":     decodeKickURL,
 		"www.kick.com": decodeKickURL,
@@ -70,17 +121,30 @@ var (
 		"www.instagram.com":   decodeInstagramURL,
 		"m.instagram.com":     decodeInstagramURL,
 
+		// LeetCode
+		"leetcode.com":     decodeLeetCodeURL,
+		"www.leetcode.com": decodeLeetCodeURL,
+
 		// Letterboxd
 		"letterboxd.com":     decodeLetterboxdURL,
 		"www.letterboxd.com": decodeLetterboxdURL,
+		"boxd.it":            decodeLetterboxdURL,
+
+		// Line
+		"line.me":     decodeLineURL,
+		"www.line.me": decodeLineURL,
 
 		// LinkedIn
 		"linkedin.com":     decodeLinkedInURL,
 		"www.linkedin.com": decodeLinkedInURL,
 
+		// Matrix
+		"matrix.to": decodeMatrixURL,
+
 		// Medium
 		"medium.com":     decodeMediumURL,
 		"www.medium.com": decodeMediumURL,
+		"*.medium.com":   decodeMediumURL,
 
 		// Toph
 		"toph.co": decodeTophURL,
@@ -100,20 +164,34 @@ var (
 		"m.me":     decodeMessengerURL,
 		"www.m.me": decodeMessengerURL,
 
+		// Nostr
+		"njump.me":       decodeNostrURL,
+		"iris.to":        decodeNostrURL,
+		"nostr.band":     decodeNostrURL,
+		"nostr.com":      decodeNostrURL,
+		"primal.net":     decodeNostrURL,
+		"snort.social":   decodeNostrURL,
+		"coracle.social": decodeNostrURL,
+
 		// Patreon
 		"patreon.com":     decodePatreonURL,
 		"www.patreon.com": decodePatreonURL,
 
-		// Pinterest
-		"pinterest.com":     decodePinterestURL,
-		"www.pinterest.com": decodePinterestURL,
+		// Pinterest, along with its localized domains from pinterestDomains
+		"pin.it": decodePinterestURL,
 
 		// Signal
-		"signal.me": decodeSignalURL,
+		"signal.me":    decodeSignalURL,
+		"signal.group": decodeSignalURL,
+
+		// Slack
+		"join.slack.com": decodeSlackURL,
+		"*.slack.com":    decodeSlackURL,
 
 		// Snapchat
-		"snapchat.com":     decodeSnapchatURL,
-		"www.snapchat.com": decodeSnapchatURL,
+		"snapchat.com":       decodeSnapchatURL,
+		"www.snapchat.com":   decodeSnapchatURL,
+		"story.snapchat.com": decodeSnapchatURL,
 
 		// Sourcehut
 		"sr.ht": decodeSourcehutURL,
@@ -121,6 +199,24 @@ var (
 		// SoundCloud
 		"soundcloud.com":     decodeSoundCloudURL,
 		"www.soundcloud.com": decodeSoundCloudURL,
+		"m.soundcloud.com":   decodeSoundCloudURL,
+		"on.soundcloud.com":  decodeSoundCloudURL,
+
+		// Stack Exchange
+		"stackoverflow.com":   decodeStackExchangeURL,
+		"*.stackoverflow.com": decodeStackExchangeURL,
+		"superuser.com":       decodeStackExchangeURL,
+		"*.superuser.com":     decodeStackExchangeURL,
+		"serverfault.com":     decodeStackExchangeURL,
+		"*.serverfault.com":   decodeStackExchangeURL,
+		"askubuntu.com":       decodeStackExchangeURL,
+		"*.askubuntu.com":     decodeStackExchangeURL,
+		"mathoverflow.net":    decodeStackExchangeURL,
+		"*.mathoverflow.net":  decodeStackExchangeURL,
+		"stackapps.com":       decodeStackExchangeURL,
+		"*.stackapps.com":     decodeStackExchangeURL,
+		"stackexchange.com":   decodeStackExchangeURL,
+		"*.stackexchange.com": decodeStackExchangeURL,
 
 		// Steam
 		"steamcommunity.com":     decodeSteamURL,
@@ -130,7 +226,10 @@ var (
 		"open.spotify.com": decodeSpotifyURL,
 
 		// Substack
-		"*.substack.com": decodeSubstackURL,
+		"substack.com":      decodeSubstackURL,
+		"www.substack.com":  decodeSubstackURL,
+		"open.substack.com": decodeSubstackURL,
+		"*.substack.com":    decodeSubstackURL,
 
 		// Reddit
 		"reddit.com":       decodeRedditURL,
@@ -142,6 +241,8 @@ var (
 		"www.tiktok.com": decodeTikTokURL,
 
 		// Threads
+		"threads.com":     decodeThreadsURL,
+		"www.threads.com": decodeThreadsURL,
 		"threads.net":     decodeThreadsURL,
 		"www.threads.net": decodeThreadsURL,
 
@@ -155,65 +256,155 @@ var (
 		"twitter.com":     decodeTwitterURL,
 		"www.twitter.com": decodeTwitterURL,
 
+		// Viber
+		"invite.viber.com": decodeViberURL,
+		"chats.viber.com":  decodeViberURL,
+
 		// Vimeo
-		"vimeo.com":     decodeVimeoURL,
-		"www.vimeo.com": decodeVimeoURL,
+		"vimeo.com":        decodeVimeoURL,
+		"www.vimeo.com":    decodeVimeoURL,
+		"player.vimeo.com": decodeVimeoURL,
+
+		// WeChat
+		"u.wechat.com":  decodeWeChatURL,
+		"weixin.qq.com": decodeWeChatURL,
 
 		// WhatsApp
-		"wa.me":     decodeWhatsAppURL,
-		"www.wa.me": decodeWhatsAppURL,
+		"wa.me":             decodeWhatsAppURL,
+		"www.wa.me":         decodeWhatsAppURL,
+		"api.whatsapp.com":  decodeWhatsAppURL,
+		"chat.whatsapp.com": decodeWhatsAppURL,
+		"whatsapp.com":      decodeWhatsAppURL,
+		"www.whatsapp.com":  decodeWhatsAppURL,
 
 		// YouTube
 		"youtube.com":       decodeYouTubeURL,
 		"www.youtube.com":   decodeYouTubeURL,
 		"m.youtube.com":     decodeYouTubeURL,
 	}
+
+	// decodeSchemeURLFuncs holds decoders for URLs with app-specific schemes,
+	// which are matched by scheme instead of host.
+	decodeSchemeURLFuncs = map[string]decodeURLFunc{
+		// Bluesky
+		"at": decodeBlueskyURL,
+
+		// Matrix
+		"matrix": decodeMatrixURL,
+
+		// Nostr
+		"nostr": decodeNostrURL,
+
+		// Signal
+		"sgnl": decodeSignalURL,
+
+		// Telegram
+		"tg": decodeTelegramURL,
+
+		// Viber
+		"viber": decodeViberURL,
+	}
 )
 
+// customDecodeURLFuncs holds decoders for hosts registered at runtime, such as
+// the custom domains of Substack publications, along with the keys they were
+// registered under.
+var (
+	customDecodeURLFuncsMu sync.RWMutex
+	customDecodeURLFuncs   = map[string]decodeURLFunc{}
+	customDecodeURLKeys    = map[string]string{}
+)
+
+// registerDecodeURLFunc registers decodeFunc for hosts under key. It registers
+// nothing and returns false if any of hosts is registered under another key.
+func registerDecodeURLFunc(hosts []string, key string, decodeFunc decodeURLFunc) bool {
+	customDecodeURLFuncsMu.Lock()
+	defer customDecodeURLFuncsMu.Unlock()
+	for _, host := range hosts {
+		if k, ok := customDecodeURLKeys[host]; ok && k != key {
+			return false
+		}
+	}
+	for _, host := range hosts {
+		customDecodeURLFuncs[host] = decodeFunc
+		customDecodeURLKeys[host] = key
+	}
+	return true
+}
+
+func lookupDecodeURLFunc(pattern string) (decodeURLFunc, bool) {
+	if decodeFunc, ok := decodeURLFuncs[pattern]; ok {
+		return decodeFunc, true
+	}
+
+	customDecodeURLFuncsMu.RLock()
+	defer customDecodeURLFuncsMu.RUnlock()
+	decodeFunc, ok := customDecodeURLFuncs[pattern]
+	return decodeFunc, ok
+}
+
 // Service identifies a social media service.
 type Service string
 
 // Supported social media services.
 const (
-	Bandcamp    Service = "Bandcamp"
-	Behance     Service = "Behance"
-	Bitbucket   Service = "Bitbucket"
-	Bluesky     Service = "Bluesky"
-	Codeberg    Service = "Codeberg"
-	DeviantArt  Service = "DeviantArt"
-	Dribbble    Service = "Dribbble"
-	Facebook    Service = "Facebook"
-	FLOSSSocial Service = "FLOSSSocial"
-	Fosstodon   Service = "Fosstodon"
-	GitHub      Service = "GitHub"
-	GitLab      Service = "GitLab"
-	Goodreads   Service = "Goodreads"
-	Instagram   Service = "Instagram"
-	Kick        Service = "Kick"
-	Kofi        Service = "Kofi"
-	Letterboxd  Service = "Letterboxd"
-	LinkedIn    Service = "LinkedIn"
-	Mastodon    Service = "Mastodon"
-	Medium      Service = "Medium"
-	Messenger   Service = "Messenger"
-	Patreon     Service = "Patreon"
-	Pinterest   Service = "Pinterest"
-	Reddit      Service = "Reddit"
-	Signal      Service = "Signal"
-	Snapchat    Service = "Snapchat"
-	Sourcehut   Service = "Sourcehut"
-	SoundCloud  Service = "SoundCloud"
-	Spotify     Service = "Spotify"
-	Steam       Service = "Steam"
-	Substack    Service = "Substack"
-	Telegram    Service = "Telegram"
-	Threads     Service = "Threads"
-	TikTok      Service = "TikTok"
-	Toph        Service = "Toph"
-	Tumblr      Service = "Tumblr"
-	Twitch      Service = "Twitch"
-	Twitter     Service = "Twitter"
-	Vimeo       Service = "Vimeo"
-	WhatsApp    Service = "WhatsApp"
-	YouTube     Service = "YouTube"
+	AtCoder       Service = "AtCoder"
+	Bandcamp      Service = "Bandcamp"
+	Behance       Service = "Behance"
+	Bitbucket     Service = "Bitbucket"
+	Bluesky       Service = "Bluesky"
+	Codeberg      Service = "Codeberg"
+	Codeforces    Service = "Codeforces"
+	DeviantArt    Service = "DeviantArt"
+	DevTo         Service = "DevTo"
+	Discord       Service = "Discord"
+	Dribbble      Service = "Dribbble"
+	Facebook      Service = "Facebook"
+	FLOSSSocial   Service = "FLOSSSocial"
+	Fosstodon     Service = "Fosstodon"
+	GitHub        Service = "GitHub"
+	GitLab        Service = "GitLab"
+	Goodreads     Service = "Goodreads"
+	HackerNews    Service = "HackerNews"
+	HackerRank    Service = "HackerRank"
+	Hashnode      Service = "Hashnode"
+	Instagram     Service = "Instagram"
+	Kaggle        Service = "Kaggle"
+	KakaoTalk     Service = "KakaoTalk"
+	Keybase       Service = "Keybase"
+	Kick          Service = "Kick"
+	Kofi          Service = "Kofi"
+	LeetCode      Service = "LeetCode"
+	Letterboxd    Service = "Letterboxd"
+	Line          Service = "Line"
+	LinkedIn      Service = "LinkedIn"
+	Mastodon      Service = "Mastodon"
+	Matrix        Service = "Matrix"
+	Medium        Service = "Medium"
+	Messenger     Service = "Messenger"
+	Nostr         Service = "Nostr"
+	Patreon       Service = "Patreon"
+	Pinterest     Service = "Pinterest"
+	Reddit        Service = "Reddit"
+	Signal        Service = "Signal"
+	Slack         Service = "Slack"
+	Snapchat      Service = "Snapchat"
+	Sourcehut     Service = "Sourcehut"
+	SoundCloud    Service = "SoundCloud"
+	Spotify       Service = "Spotify"
+	StackExchange Service = "StackExchange"
+	Steam         Service = "Steam"
+	Substack      Service = "Substack"
+	Telegram      Service = "Telegram"
+	Threads       Service = "Threads"
+	TikTok        Service = "TikTok"
+	Toph          Service = "Toph"
+	Tumblr        Service = "Tumblr"
+	Twitch        Service = "Twitch"
+	Twitter       Service = "Twitter"
+	Viber         Service = "Viber"
+	Vimeo         Service = "Vimeo"
+	WeChat        Service = "WeChat"
+	WhatsApp      Service = "WhatsApp"
+	YouTube       Service = "YouTube"
 )
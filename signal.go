@@ -1,51 +1,126 @@
 package slinky
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"strings"
 )
 
-// Signal Account: ^https://signal\.me/#p/\+[0-9]{7,15}$
+// Signal Account: ^(https|sgnl)://signal\.me/#p/\+[0-9]{7,15}$
+// Signal Username: ^(https|sgnl)://signal\.me/#eu/[A-Za-z0-9_-]{64}$
+// Signal Group: ^(https|sgnl)://signal\.group/#[A-Za-z0-9_-]+$
 
 func decodeSignalURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
 		url.Scheme = "https"
 	}
-	if url.Scheme != "https" {
+	if url.Scheme != "https" && url.Scheme != "sgnl" {
 		return nil, fmt.Errorf("%w: invalid Signal scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "signal.me" {
+	// Signal keeps everything but the host in the fragment, so that it is
+	// never sent to the server.
+	switch url.Host {
+	case "signal.me":
+		kind, payload, _ := strings.Cut(url.Fragment, "/")
+		switch kind {
+		case "p":
+			return newSignalAccountURL(url, payload)
+		case "eu":
+			return newSignalUsernameURL(url, payload)
+		default:
+			return nil, fmt.Errorf("%w: invalid Signal path", ErrInvalidURL)
+		}
+
+	case "signal.group":
+		return newSignalGroupURL(url, url.Fragment)
+
+	default:
 		return nil, fmt.Errorf("%w: invalid Signal host", ErrInvalidURL)
 	}
+}
 
-	fragment := url.Fragment
-	if !strings.HasPrefix(fragment, "p/") {
-		return nil, fmt.Errorf("%w: invalid Signal path", ErrInvalidURL)
+func newSignalAccountURL(url *url.URL, s string) (*URL, error) {
+	phoneNumber, ok := parsePhoneNumber(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid Signal phone number", ErrInvalidURL)
 	}
 
-	phoneNumber := strings.TrimPrefix(fragment, "p/")
-	if len(phoneNumber) < 8 || len(phoneNumber) > 16 {
-		return nil, fmt.Errorf("%w: invalid Signal phone number length", ErrInvalidURL)
+	return &URL{
+		Service: Signal,
+		Type:    "Account",
+		ID:      phoneNumber.String(),
+		Data:    phoneNumber.data(),
+		URL:     url,
+	}, nil
+}
+
+func newSignalUsernameURL(url *url.URL, payload string) (*URL, error) {
+	// The payload holds 32 bytes of entropy, used to encrypt the username,
+	// followed by the 16-byte UUID the server stores the ciphertext under.
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil || len(b) != 48 {
+		return nil, fmt.Errorf("%w: invalid Signal username link", ErrInvalidURL)
 	}
-	if strings.ContainsFunc(phoneNumber, isNotSignalPhoneNumberRune) {
-		return nil, fmt.Errorf("%w: invalid Signal phone number", ErrInvalidURL)
+
+	return &URL{
+		Service: Signal,
+		Type:    "Username",
+		ID:      payload,
+		Data: map[string]string{
+			"serverID": formatSignalUUID(b[32:]),
+		},
+		URL: url,
+	}, nil
+}
+
+func newSignalGroupURL(url *url.URL, payload string) (*URL, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(payload, "="))
+	if err != nil || !isSignalGroupInviteLinkValid(b) {
+		return nil, fmt.Errorf("%w: invalid Signal group link", ErrInvalidURL)
 	}
 
 	return &URL{
 		Service: Signal,
-		Type:    "Account",
-		ID:      phoneNumber,
+		Type:    "Group",
+		ID:      payload,
 		Data: map[string]string{
-			"phoneNumber": phoneNumber,
+			"inviteCode": payload,
 		},
 		URL: url,
 	}, nil
 }
 
-const signalPhoneNumberAlpha = "0123456789+"
+// isSignalGroupInviteLinkValid reports whether b is an encoded GroupInviteLink
+// protobuf message with version 1 contents: a 32-byte group master key and a
+// 16-byte invite link password.
+func isSignalGroupInviteLinkValid(b []byte) bool {
+	if len(b) < 2 || b[0] != 0x0a || int(b[1]) != len(b)-2 {
+		return false
+	}
+	b = b[2:]
+
+	hasMasterKey, hasPassword := false, false
+	for len(b) > 0 {
+		if len(b) < 2 || len(b) < 2+int(b[1]) {
+			return false
+		}
+		switch tag, n := b[0], int(b[1]); {
+		case tag == 0x0a && n == 32:
+			hasMasterKey = true
+		case tag == 0x12 && n == 16:
+			hasPassword = true
+		default:
+			return false
+		}
+		b = b[2+int(b[1]):]
+	}
+	return hasMasterKey && hasPassword
+}
 
-func isNotSignalPhoneNumberRune(r rune) bool {
-	return !strings.ContainsRune(signalPhoneNumberAlpha, r)
+func formatSignalUUID(b []byte) string {
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
 }
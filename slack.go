@@ -0,0 +1,177 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Slack Workspace: ^https://[a-z0-9-]{1,63}\.slack\.com/?$
+// Slack Channel: ^https://[a-z0-9-]{1,63}\.slack\.com/archives/[CDG][A-Z0-9]{8,12}/?$
+// Slack Message: ^https://[a-z0-9-]{1,63}\.slack\.com/archives/[CDG][A-Z0-9]{8,12}/p[0-9]{16}/?$
+// Slack Invite: ^https://join\.slack\.com/t/[a-z0-9-]{1,63}/shared_invite/[A-Za-z0-9_-]{1,100}/?$
+
+func decodeSlackURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Slack scheme", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) > 0 && path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Slack path", ErrInvalidURL)
+	}
+	var parts []string
+	if path != "" {
+		parts = strings.Split(strings.TrimPrefix(path, "/"), "/")
+	}
+
+	if url.Host == "join.slack.com" {
+		if len(parts) != 4 || parts[0] != "t" || parts[2] != "shared_invite" {
+			return nil, fmt.Errorf("%w: invalid Slack path", ErrInvalidURL)
+		}
+
+		workspace, code := parts[1], parts[3]
+		if !isSlackWorkspaceValid(workspace) {
+			return nil, fmt.Errorf("%w: invalid Slack workspace", ErrInvalidURL)
+		}
+		if len(code) < 1 || len(code) > 100 {
+			return nil, fmt.Errorf("%w: invalid Slack invite code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotSlackInviteCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Slack invite code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Slack,
+			Type:    "Invite",
+			ID:      code,
+			Data: map[string]string{
+				"workspace":  workspace,
+				"inviteCode": code,
+			},
+			URL: url,
+		}, nil
+	}
+
+	if !strings.HasSuffix(url.Host, ".slack.com") {
+		return nil, fmt.Errorf("%w: invalid Slack host", ErrInvalidURL)
+	}
+	workspace := strings.TrimSuffix(url.Host, ".slack.com")
+	if !isSlackWorkspaceValid(workspace) || slackReservedSubdomains[workspace] {
+		return nil, fmt.Errorf("%w: invalid Slack workspace", ErrInvalidURL)
+	}
+
+	switch {
+	case len(parts) == 0:
+		return &URL{
+			Service: Slack,
+			Type:    "Workspace",
+			ID:      workspace,
+			Data: map[string]string{
+				"workspace": workspace,
+			},
+			URL: url,
+		}, nil
+
+	case (len(parts) == 2 || len(parts) == 3) && parts[0] == "archives":
+		channelID := parts[1]
+		if !isSlackChannelIDValid(channelID) {
+			return nil, fmt.Errorf("%w: invalid Slack channel ID", ErrInvalidURL)
+		}
+
+		if len(parts) == 2 {
+			return &URL{
+				Service: Slack,
+				Type:    "Channel",
+				ID:      channelID,
+				Data: map[string]string{
+					"workspace": workspace,
+					"channelID": channelID,
+				},
+				URL: url,
+			}, nil
+		}
+
+		// Message permalinks carry the message timestamp, as seconds and
+		// microseconds, with the decimal point dropped and a "p" prefix.
+		ts := parts[2]
+		if len(ts) != 17 || ts[0] != 'p' || strings.ContainsFunc(ts[1:], isNotSlackTimestampRune) {
+			return nil, fmt.Errorf("%w: invalid Slack message timestamp", ErrInvalidURL)
+		}
+		messageTS := ts[1:11] + "." + ts[11:]
+
+		return &URL{
+			Service: Slack,
+			Type:    "Message",
+			ID:      channelID + "/" + messageTS,
+			Data: map[string]string{
+				"workspace": workspace,
+				"channelID": channelID,
+				"messageTS": messageTS,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Slack path", ErrInvalidURL)
+	}
+}
+
+func isSlackWorkspaceValid(workspace string) bool {
+	if len(workspace) < 1 || len(workspace) > 63 || strings.ContainsFunc(workspace, isNotSlackWorkspaceRune) {
+		return false
+	}
+	return workspace[0] != '-' && workspace[len(workspace)-1] != '-'
+}
+
+// isSlackChannelIDValid reports whether id is the ID of a public channel
+// ("C"), private channel ("G") or direct message ("D").
+func isSlackChannelIDValid(id string) bool {
+	if len(id) < 9 || len(id) > 13 || strings.ContainsFunc(id, isNotSlackIDRune) {
+		return false
+	}
+	return id[0] == 'C' || id[0] == 'D' || id[0] == 'G'
+}
+
+// slackReservedSubdomains holds the subdomains Slack serves its web app, API,
+// files, webhooks and invite links from, alongside those of workspaces.
+var slackReservedSubdomains = map[string]bool{
+	"a":       true,
+	"api":     true,
+	"app":     true,
+	"edgeapi": true,
+	"files":   true,
+	"get":     true,
+	"help":    true,
+	"hooks":   true,
+	"join":    true,
+	"status":  true,
+	"www":     true,
+}
+
+const slackWorkspaceAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotSlackWorkspaceRune(r rune) bool {
+	return !strings.ContainsRune(slackWorkspaceAlpha, r)
+}
+
+const slackIDAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func isNotSlackIDRune(r rune) bool {
+	return !strings.ContainsRune(slackIDAlpha, r)
+}
+
+const slackTimestampAlpha = "0123456789"
+
+func isNotSlackTimestampRune(r rune) bool {
+	return !strings.ContainsRune(slackTimestampAlpha, r)
+}
+
+const slackInviteCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotSlackInviteCodeRune(r rune) bool {
+	return !strings.ContainsRune(slackInviteCodeAlpha, r)
+}
@@ -18,7 +18,7 @@ type URL struct {
 //
 // The url must be absolute (starting with a scheme).
 func Parse(rawURL string) (*URL, error) {
-	url, err := url.Parse(rawURL)
+	url, err := parseURL(rawURL)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +26,16 @@ func Parse(rawURL string) (*URL, error) {
 		return nil, ErrNotAbsolute
 	}
 
-	for _, pattern := range hostPatterns(url.Host, 1) {
-		decodeFunc, ok := decodeURLFuncs[pattern]
+	if decodeFunc, ok := decodeSchemeURLFuncs[url.Scheme]; ok {
+		return decodeFunc(url)
+	}
+
+	if host, ok := normalizeHost(url.Hostname()); ok {
+		url.Host = host
+	}
+
+	for _, pattern := range hostPatterns(url.Host) {
+		decodeFunc, ok := lookupDecodeURLFunc(pattern)
 		if ok {
 			return decodeFunc(url)
 		}
@@ -35,11 +43,70 @@ func Parse(rawURL string) (*URL, error) {
 	return nil, ErrUnknownService
 }
 
-func hostPatterns(host string, maxWildcards int) []string {
-	parts := strings.Split(host, ".")
-	patterns := make([]string, 0, len(parts)-1)
-	for i := 0; i <= maxWildcards && i < len(parts)-1; i++ {
-		patterns = append(patterns, strings.Repeat("*.", i)+strings.Join(parts[i:], "."))
+// parseURL parses rawURL like url.Parse. For URLs with schemes that are
+// matched by scheme, it also accepts authorities that are not valid hosts,
+// such as the DIDs in AT-URIs, and keeps them in Host as is.
+func parseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err == nil {
+		return u, nil
+	}
+
+	scheme, rest, ok := strings.Cut(rawURL, "://")
+	if !ok {
+		return nil, err
+	}
+	if _, ok := decodeSchemeURLFuncs[strings.ToLower(scheme)]; !ok {
+		return nil, err
+	}
+
+	i := strings.IndexAny(rest, "/?#")
+	if i < 0 {
+		i = len(rest)
+	}
+	u, err2 := url.Parse(scheme + "://" + rest[i:])
+	if err2 != nil {
+		return nil, err
+	}
+	u.Host = rest[:i]
+	return u, nil
+}
+
+// hostPatterns returns the registry patterns that host may match, most
+// specific first: host itself, then a wildcard pattern for each of its parent
+// domains down to its registrable domain. A pattern like "*.bandcamp.com"
+// thus matches any subdomain of bandcamp.com, but no pattern ever spans a
+// public suffix like "*.co.uk".
+func hostPatterns(host string) []string {
+	patterns := []string{host}
+	if !isDomainNameValid(host) {
+		return patterns
+	}
+	domain, ok := registrableDomain(host)
+	if !ok {
+		return patterns
+	}
+	for host != domain {
+		_, host, _ = strings.Cut(host, ".")
+		patterns = append(patterns, "*."+host)
 	}
 	return patterns
 }
+
+// rawQueryValue returns the first value of key in rawQuery. Unlike url.Query,
+// it keeps "+" as is rather than reading it as a space, as services like
+// WhatsApp and Viber do not treat it as one.
+func rawQueryValue(rawQuery, key string) string {
+	for _, pair := range strings.Split(rawQuery, "&") {
+		k, v, _ := strings.Cut(pair, "=")
+		if k != key {
+			continue
+		}
+		value, err := url.PathUnescape(v)
+		if err != nil {
+			return ""
+		}
+		return value
+	}
+	return ""
+}
@@ -1,10 +1,12 @@
 package slinky
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -205,7 +207,7 @@ func TestParse(t *testing.T) {
 		},
 		{
 			in:   "https://wa.me/+1234567890/",
-			want: wantWithURL(wantWhatsAppPlus1234567890, must(url.Parse("https://wa.me/+1234567890/"))),
+			want: wantWithURL(wantWhatsApp1234567890, must(url.Parse("https://wa.me/+1234567890/"))),
 		},
 		{
 			in:      "https://wa.me/123456",
@@ -364,8 +366,8 @@ func TestParse(t *testing.T) {
 			want: wantWithURL(wantMediumHjr265, must(url.Parse("https://www.medium.com/@hjr265/"))),
 		},
 		{
-			in:      "https://medium.com/hjr265",
-			wantErr: ErrInvalidURL,
+			in:   "https://medium.com/hjr265",
+			want: wantWithURL(wantMediumPublicationHjr265, must(url.Parse("https://medium.com/hjr265"))),
 		},
 		{
 			in:   "https://hjr265.substack.com",
@@ -503,418 +505,2890 @@ func TestParse(t *testing.T) {
 			in:      "https://toph.co/hjr265",
 			wantErr: ErrInvalidURL,
 		},
-	} {
-		t.Run(c.in, func(t *testing.T) {
-			got, err := Parse(c.in)
-			if c.wantErr != nil {
-				if !errors.Is(err, c.wantErr) {
-					t.Fatalf("want error %q, got %q", c.wantErr, err)
-				}
-			} else if err != nil {
-				t.Fatal(err)
-			}
-			if !cmp.Equal(c.want, got) {
-				t.Fatal(cmp.Diff(c.want, got))
-			}
-		})
-	}
-}
-
-var (
-	wantFacebookHjr265 = &URL{
-		Service: Facebook,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://vimeo.com/76979871",
+			want: wantWithURL(wantVimeoVideo76979871, must(url.Parse("https://vimeo.com/76979871"))),
 		},
-	}
-	wantFacebookMahmudrayed152 = &URL{
-		Service: Facebook,
-		Type:    "Profile",
-		ID:      "mahmud.rayed.152",
-		Data: map[string]string{
-			"username": "mahmud.rayed.152",
+		{
+			in:   "https://vimeo.com/76979871/8272103f6e",
+			want: wantWithURL(wantVimeoVideo76979871Unlisted, must(url.Parse("https://vimeo.com/76979871/8272103f6e"))),
 		},
-	}
-	wantFLOSSSocialHjr265 = &URL{
-		Service: FLOSSSocial,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
-			"platform": "Mastodon",
+		{
+			in:   "https://player.vimeo.com/video/76979871?h=8272103f6e",
+			want: wantWithURL(wantVimeoVideo76979871Unlisted, must(url.Parse("https://player.vimeo.com/video/76979871?h=8272103f6e"))),
 		},
-	}
-	wantFosstodonHjr265 = &URL{
-		Service: Fosstodon,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
-			"platform": "Mastodon",
+		{
+			in:      "https://vimeo.com/76979871/XYZ",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantGitHubHjr265 = &URL{
-		Service: GitHub,
-		Type:    "User",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://vimeo.com/channels/staffpicks/76979871",
+			want: wantWithURL(wantVimeoVideo76979871Staffpicks, must(url.Parse("https://vimeo.com/channels/staffpicks/76979871"))),
 		},
-	}
-	wantLinkedInHjr265 = &URL{
-		Service: LinkedIn,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://vimeo.com/channels/staffpicks",
+			want: wantWithURL(wantVimeoChannelStaffpicks, must(url.Parse("https://vimeo.com/channels/staffpicks"))),
 		},
-		URL: must(url.Parse("https://www.linkedin.com/in/hjr265/")),
-	}
-	wantTelegramHjr265 = &URL{
-		Service: Telegram,
-		Type:    "Account",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://vimeo.com/showcase/1234567",
+			want: wantWithURL(wantVimeoShowcase1234567, must(url.Parse("https://vimeo.com/showcase/1234567"))),
 		},
-	}
-	wantTelegramKeyboardCatPhoneNumber = &URL{
-		Service: Telegram,
-		Type:    "Account",
-		ID:      "+100000000000001",
-		Data: map[string]string{
-			"phoneNumber": "+100000000000001",
+		{
+			in:      "https://vimeo.com/showcase/abc",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantTwitterHjr265 = &URL{
-		Service: Twitter,
-		Type:    "Account",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://vimeo.com/groups/animation",
+			want: wantWithURL(wantVimeoGroupAnimation, must(url.Parse("https://vimeo.com/groups/animation"))),
 		},
-	}
-	wantFacebookIAmKeyboardCat = &URL{
-		Service: Facebook,
-		Type:    "Profile",
-		ID:      "I.AM.KEYBOARDCAT",
-		Data: map[string]string{
-			"username": "I.AM.KEYBOARDCAT",
+		{
+			in:   "https://vimeo.com/user12345",
+			want: wantWithURL(wantVimeoUser12345, must(url.Parse("https://vimeo.com/user12345"))),
 		},
-	}
-	wantFacebookIAmKeyboardCatProfileID = &URL{
-		Service: Facebook,
-		Type:    "Profile",
-		ID:      "100000000000001",
-		Data: map[string]string{
-			"profileID": "100000000000001",
+		{
+			in:      "https://player.vimeo.com/76979871",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantInstagramIAmKeyboardCat = &URL{
-		Service: Instagram,
-		Type:    "Profile",
-		ID:      "I.AM.KEYBOARDCAT",
-		Data: map[string]string{
-			"username": "I.AM.KEYBOARDCAT",
+		{
+			in:   "https://t.me/s/hjr265",
+			want: wantWithURL(wantTelegramHjr265, must(url.Parse("https://t.me/s/hjr265"))),
 		},
-	}
-	wantInstagramRayed152 = &URL{
-		Service: Instagram,
-		Type:    "Profile",
-		ID:      "rayed152",
-		Data: map[string]string{
-			"username": "rayed152",
+		{
+			in:   "https://t.me/hjr265/42",
+			want: wantWithURL(wantTelegramHjr265Post42, must(url.Parse("https://t.me/hjr265/42"))),
 		},
-	}
-	wantYouTubeIAmKeyboardCat = &URL{
-		Service: YouTube,
-		Type:    "Channel",
-		ID:      "I-AM_KEYBOARDCAT",
-		Data: map[string]string{
-			"channelID": "I-AM_KEYBOARDCAT",
+		{
+			in:   "https://t.me/s/hjr265/42",
+			want: wantWithURL(wantTelegramHjr265Post42, must(url.Parse("https://t.me/s/hjr265/42"))),
 		},
-	}
-	wantRedditAcceptableMix8356 = &URL{
-		Service: Reddit,
-		Type:    "User",
-		ID:      "Acceptable-Mix8356",
-		Data: map[string]string{
-			"username": "Acceptable-Mix8356",
+		{
+			in:      "https://t.me/hjr265/abc",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantSubRedditIdk152 = &URL{
-		Service: Reddit,
-		Type:    "Subreddit",
-		ID:      "idk_1_52",
-		Data: map[string]string{
-			"username": "idk_1_52",
+		{
+			in:   "https://t.me/+AbCdEf0123456789",
+			want: wantWithURL(wantTelegramInviteAbCdEf, must(url.Parse("https://t.me/+AbCdEf0123456789"))),
 		},
-	}
-	wantPinterestRayed152 = &URL{
-		Service: Pinterest,
-		Type:    "Profile",
-		ID:      "rayed152",
-		Data: map[string]string{
-			"username": "rayed152",
+		{
+			in:   "https://t.me/joinchat/AbCdEf0123456789",
+			want: wantWithURL(wantTelegramInviteAbCdEf, must(url.Parse("https://t.me/joinchat/AbCdEf0123456789"))),
 		},
-	}
-	wantYouTubeMahmudRayed = &URL{
-		Service: YouTube,
-		Type:    "Channel",
-		ID:      "MahmudRayed",
-		Data: map[string]string{
-			"channelID": "MahmudRayed",
+		{
+			in:   "https://t.me/+AbCdEf",
+			want: wantWithURL(wantTelegramInviteAbCdEfShort, must(url.Parse("https://t.me/+AbCdEf"))),
 		},
-	}
-	wantMessengerMahmudRayed = &URL{
-		Service: Messenger,
-		Type:    "User",
-		ID:      "6585231744937052",
-		Data: map[string]string{
-			"username": "6585231744937052",
+		{
+			in:      "https://t.me/+",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantSnapchatHjr265 = &URL{
-		Service: Snapchat,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://t.me/addstickers/Animals",
+			want: wantWithURL(wantTelegramStickerSetAnimals, must(url.Parse("https://t.me/addstickers/Animals"))),
 		},
-	}
-	wantSignal1234567890 = &URL{
-		Service: Signal,
-		Type:    "Account",
-		ID:      "+1234567890",
-		Data: map[string]string{
-			"phoneNumber": "+1234567890",
+		{
+			in:   "tg://resolve?domain=hjr265",
+			want: wantWithURL(wantTelegramHjr265, must(url.Parse("tg://resolve?domain=hjr265"))),
 		},
-	}
-	wantGitLabHjr265 = &URL{
-		Service: GitLab,
-		Type:    "User",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "tg://resolve?domain=hjr265&post=42",
+			want: wantWithURL(wantTelegramHjr265Post42, must(url.Parse("tg://resolve?domain=hjr265&post=42"))),
 		},
-	}
-	wantBitbucketHjr265 = &URL{
-		Service: Bitbucket,
-		Type:    "User",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "tg://resolve?phone=100000000000001",
+			want: wantWithURL(wantTelegramKeyboardCatPhoneNumber, must(url.Parse("tg://resolve?phone=100000000000001"))),
 		},
-	}
-	wantCodebergHjr265 = &URL{
-		Service: Codeberg,
-		Type:    "User",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "tg://join?invite=AbCdEf0123456789",
+			want: wantWithURL(wantTelegramInviteAbCdEf, must(url.Parse("tg://join?invite=AbCdEf0123456789"))),
 		},
-	}
-	wantMediumHjr265 = &URL{
-		Service: Medium,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "tg://addstickers?set=Animals",
+			want: wantWithURL(wantTelegramStickerSetAnimals, must(url.Parse("tg://addstickers?set=Animals"))),
 		},
-	}
-	wantSubstackHjr265 = &URL{
-		Service: Substack,
-		Type:    "Publication",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:      "tg://settings",
+			wantErr: ErrInvalidURL,
 		},
-	}
-	wantPatreonHjr265 = &URL{
-		Service: Patreon,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://wa.me/1234567890?text=Hello%20there%21",
+			want: wantWithURL(wantWhatsApp1234567890HelloThere, must(url.Parse("https://wa.me/1234567890?text=Hello%20there%21"))),
 		},
-	}
-	wantKofiHjr265 = &URL{
-		Service: Kofi,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in:   "https://api.whatsapp.com/send?phone=1234567890&text=Hello%20there%21",
+			want: wantWithURL(wantWhatsApp1234567890HelloThere, must(url.Parse("https://api.whatsapp.com/send?phone=1234567890&text=Hello%20there%21"))),
 		},
-	}
-	wantDribbbleHjr265 = &URL{
-		Service: Dribbble,
-		Type:    "Profile",
-		ID:      "hjr265",
-		Data: map[string]string{
-			"username": "hjr265",
+		{
+			in: "https://wa.me/1234567890?text=1+1",
+			want: &URL{
+				Service: WhatsApp,
+				Type:    "Account",
+				ID:      "+1234567890",
+				Data: map[string]string{
+					"phoneNumber":    "+1234567890",
+					"countryCode":    "1",
+					"nationalNumber": "234567890",
+					"text":           "1+1",
+				},
+				URL: must(url.Parse("https://wa.me/1234567890?text=1+1")),
+			},
+		},
+		{
+			in:      "https://api.whatsapp.com/send?phone=123",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://chat.whatsapp.com/AbCdEfGhIjKlMnOpQrStUv",
+			want: wantWithURL(wantWhatsAppGroupAbCdEf, must(url.Parse("https://chat.whatsapp.com/AbCdEfGhIjKlMnOpQrStUv"))),
+		},
+		{
+			in:      "https://chat.whatsapp.com/AbCd",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://whatsapp.com/channel/0029VaAbCdEfGhIjKlMnOpQr",
+			want: wantWithURL(wantWhatsAppChannel0029Va, must(url.Parse("https://whatsapp.com/channel/0029VaAbCdEfGhIjKlMnOpQr"))),
+		},
+		{
+			in:      "https://www.whatsapp.com/download",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://wa.me/2801234567",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://t.me/+0123456789",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "sgnl://signal.me/#p/+1234567890",
+			want: wantWithURL(wantSignal1234567890, must(url.Parse("sgnl://signal.me/#p/+1234567890"))),
+		},
+		{
+			in:   "https://signal.me/#eu/AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8BI0VniavN7wEjRWeJq83v",
+			want: wantWithURL(wantSignalUsername, must(url.Parse("https://signal.me/#eu/AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8BI0VniavN7wEjRWeJq83v"))),
+		},
+		{
+			in:   "sgnl://signal.me/#eu/AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8BI0VniavN7wEjRWeJq83v",
+			want: wantWithURL(wantSignalUsername, must(url.Parse("sgnl://signal.me/#eu/AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8BI0VniavN7wEjRWeJq83v"))),
+		},
+		{
+			in:      "https://signal.me/#eu/AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://signal.group/#CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX",
+			want: wantWithURL(wantSignalGroup, must(url.Parse("https://signal.group/#CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX"))),
+		},
+		{
+			in:   "sgnl://signal.group/#CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX",
+			want: wantWithURL(wantSignalGroup, must(url.Parse("sgnl://signal.group/#CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX"))),
+		},
+		{
+			in:      "https://signal.group/#CgQKAgEC",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://signal.group/#not*base64",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "sgnl://example.com/#p/+1234567890",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur",
+			want: wantWithURL(wantBlueskyDID, must(url.Parse("https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur"))),
+		},
+		{
+			in:   "at://did:plc:z72i7hdynmk6r22z27h6tvur",
+			want: wantWithURL(wantBlueskyDID, must(parseURL("at://did:plc:z72i7hdynmk6r22z27h6tvur"))),
+		},
+		{
+			in:      "https://bsky.app/profile/did:plc:tooshort",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://bsky.app/profile/hjr265.bsky.social/post/3k2a4xyzabc2y",
+			want: wantWithURL(wantBlueskyHjr265Post, must(url.Parse("https://bsky.app/profile/hjr265.bsky.social/post/3k2a4xyzabc2y"))),
+		},
+		{
+			in:   "at://hjr265.bsky.social/app.bsky.feed.post/3k2a4xyzabc2y",
+			want: wantWithURL(wantBlueskyHjr265Post, must(url.Parse("at://hjr265.bsky.social/app.bsky.feed.post/3k2a4xyzabc2y"))),
+		},
+		{
+			in:   "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/feed/whats-hot",
+			want: wantWithURL(wantBlueskyDIDFeed, must(url.Parse("https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/feed/whats-hot"))),
+		},
+		{
+			in:   "at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot",
+			want: wantWithURL(wantBlueskyDIDFeed, must(parseURL("at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot"))),
+		},
+		{
+			in:   "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/lists/3k2a4xyzabc2y",
+			want: wantWithURL(wantBlueskyDIDList, must(url.Parse("https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/lists/3k2a4xyzabc2y"))),
+		},
+		{
+			in:      "https://bsky.app/profile/hjr265.bsky.social/likes/3k2a4xyzabc2y",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "at://hjr265.bsky.social/app.bsky.feed.like/3k2a4xyzabc2y",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/hjr265..social",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://bsky.app/profile/Hjr265.Example.com",
+			want: wantWithURL(wantBlueskyHjr265ExampleCom, must(url.Parse("https://bsky.app/profile/Hjr265.Example.com"))),
+		},
+		{
+			in:   "https://bsky.app/profile/bücher.de",
+			want: wantWithURL(wantBlueskyBucherDe, must(url.Parse("https://bsky.app/profile/bücher.de"))),
+		},
+		{
+			in:   "https://bsky.app/profile/xn--bcher-kva.de",
+			want: wantWithURL(wantBlueskyBucherDe, must(url.Parse("https://bsky.app/profile/xn--bcher-kva.de"))),
+		},
+		{
+			in:      "https://bsky.app/profile/-hjr265.example.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/hjr265-.example.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/hjr265.example.123",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.example.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/hjr265.local",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://bsky.app/profile/hjr_265.example.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.threads.com/@hjr265",
+			want: wantWithURL(wantThreadsHjr265, must(url.Parse("https://www.threads.com/@hjr265"))),
+		},
+		{
+			in:   "https://threads.com/@hjr265/post/C8aBcD_eF-g",
+			want: wantWithURL(wantThreadsHjr265Post, must(url.Parse("https://threads.com/@hjr265/post/C8aBcD_eF-g"))),
+		},
+		{
+			in:   "https://www.threads.net/@hjr265/post/C8aBcD_eF-g/",
+			want: wantWithURL(wantThreadsHjr265Post, must(url.Parse("https://www.threads.net/@hjr265/post/C8aBcD_eF-g/"))),
+		},
+		{
+			in:      "https://www.threads.com/@hjr265/replies/C8aBcD_eF-g",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.threads.com/@hjr265/post/C8a.BcD",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://hjr265.medium.com",
+			want: wantWithURL(wantMediumHjr265, must(url.Parse("https://hjr265.medium.com"))),
+		},
+		{
+			in:   "https://medium.com/@hjr265/writing-a-url-parser-in-go-1a2b3c4d5e6f",
+			want: wantWithURL(wantMediumHjr265Story, must(url.Parse("https://medium.com/@hjr265/writing-a-url-parser-in-go-1a2b3c4d5e6f"))),
+		},
+		{
+			in:   "https://hjr265.medium.com/writing-a-url-parser-in-go-1a2b3c4d5e6f",
+			want: wantWithURL(wantMediumHjr265Story, must(url.Parse("https://hjr265.medium.com/writing-a-url-parser-in-go-1a2b3c4d5e6f"))),
+		},
+		{
+			in:   "https://medium.com/furqan-software/writing-a-url-parser-in-go-1a2b3c4d5e6f",
+			want: wantWithURL(wantMediumFurqanSoftwareStory, must(url.Parse("https://medium.com/furqan-software/writing-a-url-parser-in-go-1a2b3c4d5e6f"))),
+		},
+		{
+			in:   "https://medium.com/p/1a2b3c4d5e6f",
+			want: wantWithURL(wantMediumStory1a2b3c4d5e6f, must(url.Parse("https://medium.com/p/1a2b3c4d5e6f"))),
+		},
+		{
+			in:      "https://medium.com/@hjr265/writing-a-url-parser-in-go",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://medium.com/tag/golang",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://foo-bar.medium.com",
+			want: wantWithURL(wantMediumFooBar, must(url.Parse("https://foo-bar.medium.com"))),
+		},
+		{
+			in:      "https://help.medium.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://hjr265.substack.com/p/writing-a-url-parser",
+			want: wantWithURL(wantSubstackHjr265Post, must(url.Parse("https://hjr265.substack.com/p/writing-a-url-parser"))),
+		},
+		{
+			in:   "https://open.substack.com/pub/hjr265/p/writing-a-url-parser",
+			want: wantWithURL(wantSubstackHjr265Post, must(url.Parse("https://open.substack.com/pub/hjr265/p/writing-a-url-parser"))),
+		},
+		{
+			in:   "https://hjr265.substack.com/podcast",
+			want: wantWithURL(wantSubstackHjr265Podcast, must(url.Parse("https://hjr265.substack.com/podcast"))),
+		},
+		{
+			in:   "https://substack.com/@hjr265",
+			want: wantWithURL(wantSubstackHjr265Profile, must(url.Parse("https://substack.com/@hjr265"))),
+		},
+		{
+			in:   "https://substack.com/@hjr265/note/c-12345678",
+			want: wantWithURL(wantSubstackHjr265Note, must(url.Parse("https://substack.com/@hjr265/note/c-12345678"))),
+		},
+		{
+			in:      "https://substack.com/@hjr265/note/12345678",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://hjr265.substack.com/archive",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.substack.com/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://hjr265.bandcamp.com/music",
+			want: wantWithURL(wantBandcampHjr265, must(url.Parse("https://hjr265.bandcamp.com/music"))),
+		},
+		{
+			in:   "https://hjr265.bandcamp.com/album/first-light",
+			want: wantWithURL(wantBandcampHjr265AlbumFirstLight, must(url.Parse("https://hjr265.bandcamp.com/album/first-light"))),
+		},
+		{
+			in:   "https://hjr265.bandcamp.com/track/dawn/",
+			want: wantWithURL(wantBandcampHjr265TrackDawn, must(url.Parse("https://hjr265.bandcamp.com/track/dawn/"))),
+		},
+		{
+			in:   "https://hjr265.bandcamp.com/merch",
+			want: wantWithURL(wantBandcampHjr265Merch, must(url.Parse("https://hjr265.bandcamp.com/merch"))),
+		},
+		{
+			in:   "https://hjr265.bandcamp.com/merch/first-light-t-shirt",
+			want: wantWithURL(wantBandcampHjr265MerchTShirt, must(url.Parse("https://hjr265.bandcamp.com/merch/first-light-t-shirt"))),
+		},
+		{
+			in:      "https://hjr265.bandcamp.com/album/first_light",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://hjr265.bandcamp.com/community",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://bandcamp.com/hjr265",
+			want: wantWithURL(wantBandcampFanHjr265, must(url.Parse("https://bandcamp.com/hjr265"))),
+		},
+		{
+			in:      "https://bandcamp.com/discover",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://daily.bandcamp.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://m.soundcloud.com/hjr265",
+			want: wantWithURL(wantSoundCloudHjr265, must(url.Parse("https://m.soundcloud.com/hjr265"))),
+		},
+		{
+			in:   "https://soundcloud.com/hjr265/night-drive",
+			want: wantWithURL(wantSoundCloudHjr265TrackNightDrive, must(url.Parse("https://soundcloud.com/hjr265/night-drive"))),
+		},
+		{
+			in:   "https://soundcloud.com/hjr265/sets/late-hours/",
+			want: wantWithURL(wantSoundCloudHjr265PlaylistLateHours, must(url.Parse("https://soundcloud.com/hjr265/sets/late-hours/"))),
+		},
+		{
+			in:   "https://soundcloud.com/hjr265/likes",
+			want: wantWithURL(wantSoundCloudHjr265Likes, must(url.Parse("https://soundcloud.com/hjr265/likes"))),
+		},
+		{
+			in:   "https://soundcloud.com/hjr265/reposts",
+			want: wantWithURL(wantSoundCloudHjr265Reposts, must(url.Parse("https://soundcloud.com/hjr265/reposts"))),
+		},
+		{
+			in:   "https://on.soundcloud.com/aB3dE5fG7h",
+			want: wantWithURL(wantSoundCloudShortLinkaB3dE5fG7h, must(url.Parse("https://on.soundcloud.com/aB3dE5fG7h"))),
+		},
+		{
+			in:      "https://soundcloud.com/discover",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://soundcloud.com/charts/top",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://soundcloud.com/hjr265/followers",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://on.soundcloud.com/aB3-dE5",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.pinterest.com/rayed152/travel-ideas/",
+			want: wantWithURL(wantPinterestRayed152BoardTravelIdeas, must(url.Parse("https://www.pinterest.com/rayed152/travel-ideas/"))),
+		},
+		{
+			in:   "https://pinterest.co.uk/rayed152/",
+			want: wantWithURL(wantPinterestRayed152, must(url.Parse("https://pinterest.co.uk/rayed152/"))),
+		},
+		{
+			in:   "https://www.pinterest.de/rayed152/",
+			want: wantWithURL(wantPinterestRayed152, must(url.Parse("https://www.pinterest.de/rayed152/"))),
+		},
+		{
+			in:   "https://in.pinterest.com/rayed152/",
+			want: wantWithURL(wantPinterestRayed152, must(url.Parse("https://in.pinterest.com/rayed152/"))),
+		},
+		{
+			in:   "https://www.pinterest.com/pin/99360735500167749/",
+			want: wantWithURL(wantPinterestPin99360735500167749, must(url.Parse("https://www.pinterest.com/pin/99360735500167749/"))),
+		},
+		{
+			in:   "https://pin.it/4GxQk9Zb",
+			want: wantWithURL(wantPinterestShortLink4GxQk9Zb, must(url.Parse("https://pin.it/4GxQk9Zb"))),
+		},
+		{
+			in:      "https://www.pinterest.com/pin/9936073550016774a/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.pinterest.com/ideas/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.pinterest.com/rayed152/_saved/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://api.pinterest.com/rayed152/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://GitHub.com/hjr265",
+			want: wantWithURL(wantGitHubHjr265, must(url.Parse("https://github.com/hjr265"))),
+		},
+		{
+			in:   "https://github.com:443/hjr265",
+			want: wantWithURL(wantGitHubHjr265, must(url.Parse("https://github.com/hjr265"))),
+		},
+		{
+			in:   "https://hjr265.bandcamp.com./",
+			want: wantWithURL(wantBandcampHjr265, must(url.Parse("https://hjr265.bandcamp.com/"))),
+		},
+		{
+			in:      "https://hjr265.m.bandcamp.com",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://127.0.0.1/hjr265",
+			wantErr: ErrUnknownService,
+		},
+		{
+			in:   "https://www.goodreads.com/user/show/12345678-john",
+			want: wantWithURL(wantGoodreads12345678John, must(url.Parse("https://www.goodreads.com/user/show/12345678-john"))),
+		},
+		{
+			in:   "https://www.goodreads.com/hjr265",
+			want: wantWithURL(wantGoodreadsHjr265, must(url.Parse("https://www.goodreads.com/hjr265"))),
+		},
+		{
+			in:   "https://www.goodreads.com/author/show/1077326.J_K_Rowling",
+			want: wantWithURL(wantGoodreadsAuthor1077326, must(url.Parse("https://www.goodreads.com/author/show/1077326.J_K_Rowling"))),
+		},
+		{
+			in:   "https://www.goodreads.com/book/show/2767052-the-hunger-games",
+			want: wantWithURL(wantGoodreadsBook2767052, must(url.Parse("https://www.goodreads.com/book/show/2767052-the-hunger-games"))),
+		},
+		{
+			in:   "https://www.goodreads.com/series/73758-the-hunger-games/",
+			want: wantWithURL(wantGoodreadsSeries73758, must(url.Parse("https://www.goodreads.com/series/73758-the-hunger-games/"))),
+		},
+		{
+			in:   "https://www.goodreads.com/group/show/1865",
+			want: wantWithURL(wantGoodreadsGroup1865, must(url.Parse("https://www.goodreads.com/group/show/1865"))),
+		},
+		{
+			in:      "https://www.goodreads.com/book/show/abc-the-hunger-games",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.goodreads.com/book/show/2767052-",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.goodreads.com/quotes",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://letterboxd.com/film/the-godfather/",
+			want: wantWithURL(wantLetterboxdFilmTheGodfather, must(url.Parse("https://letterboxd.com/film/the-godfather/"))),
+		},
+		{
+			in:   "https://letterboxd.com/hjr265/list/favourites/",
+			want: wantWithURL(wantLetterboxdHjr265ListFavourites, must(url.Parse("https://letterboxd.com/hjr265/list/favourites/"))),
+		},
+		{
+			in:   "https://letterboxd.com/hjr265/film/the-godfather/",
+			want: wantWithURL(wantLetterboxdHjr265ReviewTheGodfather, must(url.Parse("https://letterboxd.com/hjr265/film/the-godfather/"))),
+		},
+		{
+			in:   "https://letterboxd.com/hjr265/films/diary/",
+			want: wantWithURL(wantLetterboxdHjr265Diary, must(url.Parse("https://letterboxd.com/hjr265/films/diary/"))),
+		},
+		{
+			in:   "https://boxd.it/2bZk",
+			want: wantWithURL(wantLetterboxdShortLink2bZk, must(url.Parse("https://boxd.it/2bZk"))),
+		},
+		{
+			in:      "https://letterboxd.com/film/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://letterboxd.com/films",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://letterboxd.com/film/The_Godfather/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://kick.com/hjr265/videos/6f1c2a3b-4d5e-4f60-8a7b-9c0d1e2f3a4b",
+			want: wantWithURL(wantKickHjr265Video6f1c2a3b, must(url.Parse("https://kick.com/hjr265/videos/6f1c2a3b-4d5e-4f60-8a7b-9c0d1e2f3a4b"))),
+		},
+		{
+			in:   "https://kick.com/hjr265?clip=clip_01HQ5ZP8K3V6X9",
+			want: wantWithURL(wantKickHjr265ClipClip01HQ5ZP8K3V6X9, must(url.Parse("https://kick.com/hjr265?clip=clip_01HQ5ZP8K3V6X9"))),
+		},
+		{
+			in:   "https://kick.com/hjr265/clips/clip_01HQ5ZP8K3V6X9",
+			want: wantWithURL(wantKickHjr265ClipClip01HQ5ZP8K3V6X9, must(url.Parse("https://kick.com/hjr265/clips/clip_01HQ5ZP8K3V6X9"))),
+		},
+		{
+			in:      "https://kick.com/hjr265/videos/6f1c2a3b4d5e4f608a7b9c0d1e2f3a4b",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://kick.com/hjr265?clip=",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://kick.com/categories",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://ko-fi.com/hjr265/shop",
+			want: wantWithURL(wantKofiHjr265Shop, must(url.Parse("https://ko-fi.com/hjr265/shop"))),
+		},
+		{
+			in:   "https://ko-fi.com/hjr265/commissions",
+			want: wantWithURL(wantKofiHjr265Commissions, must(url.Parse("https://ko-fi.com/hjr265/commissions"))),
+		},
+		{
+			in:   "https://ko-fi.com/s/a1b2c3d4e5",
+			want: wantWithURL(wantKofiShopItemA1b2c3d4e5, must(url.Parse("https://ko-fi.com/s/a1b2c3d4e5"))),
+		},
+		{
+			in:   "https://ko-fi.com/c/f6e5d4c3b2",
+			want: wantWithURL(wantKofiCommissionF6e5d4c3b2, must(url.Parse("https://ko-fi.com/c/f6e5d4c3b2"))),
+		},
+		{
+			in:   "https://ko-fi.com/post/New-Album-Out-Now-Q5Q8ABCDE",
+			want: wantWithURL(wantKofiPostQ5Q8ABCDE, must(url.Parse("https://ko-fi.com/post/New-Album-Out-Now-Q5Q8ABCDE"))),
+		},
+		{
+			in:      "https://ko-fi.com/post/New-Album-Out-Now-",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://ko-fi.com/explore",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.patreon.com/c/hjr265",
+			want: wantWithURL(wantPatreonHjr265, must(url.Parse("https://www.patreon.com/c/hjr265"))),
+		},
+		{
+			in:   "https://www.patreon.com/posts/new-album-out-now-98765432",
+			want: wantWithURL(wantPatreonPost98765432, must(url.Parse("https://www.patreon.com/posts/new-album-out-now-98765432"))),
+		},
+		{
+			in:   "https://www.patreon.com/user?u=12345678",
+			want: wantWithURL(wantPatreonUser12345678, must(url.Parse("https://www.patreon.com/user?u=12345678"))),
+		},
+		{
+			in:   "https://www.patreon.com/join/hjr265",
+			want: wantWithURL(wantPatreonJoinHjr265, must(url.Parse("https://www.patreon.com/join/hjr265"))),
+		},
+		{
+			in:   "https://www.patreon.com/bePatron?c=4567890",
+			want: wantWithURL(wantPatreonJoinCampaign4567890, must(url.Parse("https://www.patreon.com/bePatron?c=4567890"))),
+		},
+		{
+			in:   "https://www.patreon.com/checkout/hjr265?rid=1122334",
+			want: wantWithURL(wantPatreonCheckoutHjr265, must(url.Parse("https://www.patreon.com/checkout/hjr265?rid=1122334"))),
+		},
+		{
+			in:      "https://www.patreon.com/posts/new-album-out-now",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.patreon.com/user?u=hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.patreon.com/c",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.behance.net/gallery/187654321/Brand-Identity",
+			want: wantWithURL(wantBehanceProject187654321, must(url.Parse("https://www.behance.net/gallery/187654321/Brand-Identity"))),
+		},
+		{
+			in:      "https://www.behance.net/gallery/Brand-Identity",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://dribbble.com/shots/23456789-Mobile-App-Concept",
+			want: wantWithURL(wantDribbbleShot23456789, must(url.Parse("https://dribbble.com/shots/23456789-Mobile-App-Concept"))),
+		},
+		{
+			in:      "https://dribbble.com/shots/popular",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.deviantart.com/hjr265/art/Night-Sky-987654321",
+			want: wantWithURL(wantDeviantArtHjr265Deviation987654321, must(url.Parse("https://www.deviantart.com/hjr265/art/Night-Sky-987654321"))),
+		},
+		{
+			in:   "https://hjr265.deviantart.com/",
+			want: wantWithURL(wantDeviantArtHjr265, must(url.Parse("https://hjr265.deviantart.com/"))),
+		},
+		{
+			in:   "https://hjr265.deviantart.com/art/Night-Sky-987654321",
+			want: wantWithURL(wantDeviantArtHjr265Deviation987654321, must(url.Parse("https://hjr265.deviantart.com/art/Night-Sky-987654321"))),
+		},
+		{
+			in:      "https://www.deviantart.com/hjr265/art/Night-Sky",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://backend.deviantart.com/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.snapchat.com/@hjr265",
+			want: wantWithURL(wantSnapchatHjr265, must(url.Parse("https://www.snapchat.com/@hjr265"))),
+		},
+		{
+			in:   "https://story.snapchat.com/@hjr265",
+			want: wantWithURL(wantSnapchatHjr265Story, must(url.Parse("https://story.snapchat.com/@hjr265"))),
+		},
+		{
+			in:   "https://www.snapchat.com/t/Xy7kQ2pL",
+			want: wantWithURL(wantSnapchatShortLinkXy7kQ2pL, must(url.Parse("https://www.snapchat.com/t/Xy7kQ2pL"))),
+		},
+		{
+			in:   "https://www.snapchat.com/spotlight/W7_EDlXWTBiXAEEniNoMPwAAYdGVxbWpxZmN0AYtJ2ZbZAYtJ2ZbGAAAAAQ",
+			want: wantWithURL(wantSnapchatSpotlightW7EDlXWTBi, must(url.Parse("https://www.snapchat.com/spotlight/W7_EDlXWTBiXAEEniNoMPwAAYdGVxbWpxZmN0AYtJ2ZbZAYtJ2ZbGAAAAAQ"))),
+		},
+		{
+			in:   "https://www.snapchat.com/lens/5b9f2e1c8d7a4b3e9f0c1d2e3f4a5b6c",
+			want: wantWithURL(wantSnapchatLens5b9f2e1c, must(url.Parse("https://www.snapchat.com/lens/5b9f2e1c8d7a4b3e9f0c1d2e3f4a5b6c"))),
+		},
+		{
+			in:      "https://www.snapchat.com/add/_hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.snapchat.com/add/hjr265.",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://www.snapchat.com/@1hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://story.snapchat.com/add/hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://discord.gg/golang",
+			want: wantWithURL(wantDiscordInviteGolang, must(url.Parse("https://discord.gg/golang"))),
+		},
+		{
+			in:   "https://discord.com/invite/golang",
+			want: wantWithURL(wantDiscordInviteGolang, must(url.Parse("https://discord.com/invite/golang"))),
+		},
+		{
+			in:   "https://discordapp.com/invite/golang/",
+			want: wantWithURL(wantDiscordInviteGolang, must(url.Parse("https://discordapp.com/invite/golang/"))),
+		},
+		{
+			in:   "https://discord.com/servers/gophers-118456055842734083",
+			want: wantWithURL(wantDiscordServer118456055842734083, must(url.Parse("https://discord.com/servers/gophers-118456055842734083"))),
+		},
+		{
+			in:   "https://discord.com/users/175928689082269696",
+			want: wantWithURL(wantDiscordUser175928689082269696, must(url.Parse("https://discord.com/users/175928689082269696"))),
+		},
+		{
+			in:   "https://discord.com/channels/118456055842734083/118456055842734083",
+			want: wantWithURL(wantDiscordChannel118456055842734083, must(url.Parse("https://discord.com/channels/118456055842734083/118456055842734083"))),
+		},
+		{
+			in:   "https://canary.discord.com/channels/118456055842734083/118456055842734083/1153012345678901248",
+			want: wantWithURL(wantDiscordMessage1153012345678901248, must(url.Parse("https://canary.discord.com/channels/118456055842734083/118456055842734083/1153012345678901248"))),
+		},
+		{
+			in:   "https://discord.com/channels/@me/931234567890123456/1153012345678901248",
+			want: wantWithURL(wantDiscordDirectMessage1153012345678901248, must(url.Parse("https://discord.com/channels/@me/931234567890123456/1153012345678901248"))),
+		},
+		{
+			in:      "https://discord.com/users/1759286890",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://discord.com/users/99999999999999999999",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://discord.gg/golang_go",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://discord.com/channels/118456055842734083",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://gophers.slack.com/",
+			want: wantWithURL(wantSlackGophers, must(url.Parse("https://gophers.slack.com/"))),
+		},
+		{
+			in:   "https://gophers.slack.com/archives/C029RQSEE",
+			want: wantWithURL(wantSlackGophersChannelC029RQSEE, must(url.Parse("https://gophers.slack.com/archives/C029RQSEE"))),
+		},
+		{
+			in:   "https://gophers.slack.com/archives/C029RQSEE/p1700000000123456",
+			want: wantWithURL(wantSlackGophersMessage1700000000123456, must(url.Parse("https://gophers.slack.com/archives/C029RQSEE/p1700000000123456"))),
+		},
+		{
+			in:   "https://join.slack.com/t/gophers/shared_invite/zt-1abc2def3-AbCdEf_gHiJ",
+			want: wantWithURL(wantSlackGophersInvite, must(url.Parse("https://join.slack.com/t/gophers/shared_invite/zt-1abc2def3-AbCdEf_gHiJ"))),
+		},
+		{
+			in:      "https://app.slack.com/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://gophers.slack.com/archives/X029RQSEE",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://matrix.to/#/@hjr265:matrix.org",
+			want: wantWithURL(wantMatrixHjr265, must(url.Parse("https://matrix.to/#/@hjr265:matrix.org"))),
+		},
+		{
+			in:   "https://matrix.to/#/#go-lang:matrix.org",
+			want: wantWithURL(wantMatrixRoomGoLang, must(url.Parse("https://matrix.to/#/#go-lang:matrix.org"))),
+		},
+		{
+			in:   "https://matrix.to/#/%40hjr265%3Amatrix.org",
+			want: wantWithURL(wantMatrixHjr265, must(url.Parse("https://matrix.to/#/%40hjr265%3Amatrix.org"))),
+		},
+		{
+			in:   "matrix:u/hjr265:matrix.org",
+			want: wantWithURL(wantMatrixHjr265, must(url.Parse("matrix:u/hjr265:matrix.org"))),
+		},
+		{
+			in:   "matrix:r/go-lang:matrix.org",
+			want: wantWithURL(wantMatrixRoomGoLang, must(url.Parse("matrix:r/go-lang:matrix.org"))),
+		},
+		{
+			in:   "https://matrix.to/#/@hjr265:example.com:8448",
+			want: wantWithURL(wantMatrixHjr265ExampleCom8448, must(url.Parse("https://matrix.to/#/@hjr265:example.com:8448"))),
+		},
+		{
+			in:   "https://matrix.to/#/@hjr265:localhost:8448",
+			want: wantWithURL(wantMatrixHjr265Localhost8448, must(url.Parse("https://matrix.to/#/@hjr265:localhost:8448"))),
+		},
+		{
+			in:      "https://matrix.to/#/@hjr265:-localhost:8448",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://matrix.to/#/@hjr265:[2001:db8::1]:8448",
+			want: wantWithURL(wantMatrixHjr265IPv6, must(url.Parse("https://matrix.to/#/@hjr265:[2001:db8::1]:8448"))),
+		},
+		{
+			in:   "https://matrix.to/#/!OGEhHVWSdvArJzumhm:matrix.org?via=matrix.org&via=example.com",
+			want: wantWithURL(wantMatrixRoomOGEhHVWSdvArJzumhm, must(url.Parse("https://matrix.to/#/!OGEhHVWSdvArJzumhm:matrix.org?via=matrix.org&via=example.com"))),
+		},
+		{
+			in:   "matrix:roomid/OGEhHVWSdvArJzumhm:matrix.org?via=matrix.org&via=example.com",
+			want: wantWithURL(wantMatrixRoomOGEhHVWSdvArJzumhm, must(url.Parse("matrix:roomid/OGEhHVWSdvArJzumhm:matrix.org?via=matrix.org&via=example.com"))),
+		},
+		{
+			in:   "https://matrix.to/#/!OGEhHVWSdvArJzumhm:matrix.org/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y?via=matrix.org",
+			want: wantWithURL(wantMatrixEventBMNXxIl5, must(url.Parse("https://matrix.to/#/!OGEhHVWSdvArJzumhm:matrix.org/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y?via=matrix.org"))),
+		},
+		{
+			in:   "matrix:roomid/OGEhHVWSdvArJzumhm:matrix.org/e/bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y?via=matrix.org",
+			want: wantWithURL(wantMatrixEventBMNXxIl5, must(url.Parse("matrix:roomid/OGEhHVWSdvArJzumhm:matrix.org/e/bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y?via=matrix.org"))),
+		},
+		{
+			in:   "https://matrix.to/#/#go-lang:matrix.org/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+			want: wantWithURL(wantMatrixEventBMNXxIl5GoLang, must(url.Parse("https://matrix.to/#/#go-lang:matrix.org/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y"))),
+		},
+		{
+			in:      "https://matrix.to/#/@hjr265:matrix.org:99999",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/@hjr265:[192.168.0.1]",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/@hjr265:matrix.org/$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/#go-lang:matrix.org?via=bad_server",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "matrix:u/hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "matrix:e/bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/@hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://matrix.to/#/@HJR265:matrix.org",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://keybase.io/hjr265",
+			want: wantWithURL(wantKeybaseHjr265, must(url.Parse("https://keybase.io/hjr265"))),
+		},
+		{
+			in:   "https://keybase.io/team/furqan.dev",
+			want: wantWithURL(wantKeybaseTeamFurqanDev, must(url.Parse("https://keybase.io/team/furqan.dev"))),
+		},
+		{
+			in:      "https://keybase.io/docs",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://njump.me/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			want: wantWithURL(wantNostrNpub1sg6plzp, must(url.Parse("https://njump.me/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m"))),
+		},
+		{
+			in:   "https://primal.net/p/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			want: wantWithURL(wantNostrNpub1sg6plzp, must(url.Parse("https://primal.net/p/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m"))),
+		},
+		{
+			in:   "nostr:npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			want: wantWithURL(wantNostrNpub1sg6plzp, must(url.Parse("nostr:npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m"))),
+		},
+		{
+			in:   "nostr:note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy",
+			want: wantWithURL(wantNostrNote1tjpa5aa, must(url.Parse("nostr:note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy"))),
+		},
+		{
+			in:      "https://njump.me/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf6",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "nostr:nsec1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63qr2zmhw",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://snort.social/p/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			want: wantWithURL(wantNostrNpub1sg6plzp, must(url.Parse("https://snort.social/p/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m"))),
+		},
+		{
+			in:   "https://iris.to/NPUB1SG6PLZPTD64U62A878HEP2KEV88SWJH3TW00GJSFL8F237LMU63Q0UF63M",
+			want: wantWithURL(wantNostrNpub1sg6plzp, must(url.Parse("https://iris.to/NPUB1SG6PLZPTD64U62A878HEP2KEV88SWJH3TW00GJSFL8F237LMU63Q0UF63M"))),
+		},
+		{
+			in:   "nostr:nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck",
+			want: wantWithURL(wantNostrNprofile1qqsgydq, must(url.Parse("nostr:nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck"))),
+		},
+		{
+			in:   "https://njump.me/nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck",
+			want: wantWithURL(wantNostrNprofile1qqsgydq, must(url.Parse("https://njump.me/nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck"))),
+		},
+		{
+			in:   "http://primal.net/e/note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy",
+			want: wantWithURL(wantNostrNote1tjpa5aa, must(url.Parse("https://primal.net/e/note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy"))),
+		},
+		{
+			in:   "https://coracle.social/notes/nevent1qqs9eq76w7h3mmrdw2ycxjvc44a2l0v7yxgnjmt4as7vyl66wu3x7dspz3mhxue69uhhyetvv9ujuerpd46hxtnfdupzpq35r7yzkm4te5460u00jz4djcw0qa90zku7739qn7wj4ralhe4zqvzqqqqqqy8gv3qc",
+			want: wantWithURL(wantNostrNevent1qqs9eq7, must(url.Parse("https://coracle.social/notes/nevent1qqs9eq76w7h3mmrdw2ycxjvc44a2l0v7yxgnjmt4as7vyl66wu3x7dspz3mhxue69uhhyetvv9ujuerpd46hxtnfdupzpq35r7yzkm4te5460u00jz4djcw0qa90zku7739qn7wj4ralhe4zqvzqqqqqqy8gv3qc"))),
+		},
+		{
+			in:   "nostr:nevent1qqs9eq76w7h3mmrdw2ycxjvc44a2l0v7yxgnjmt4as7vyl66wu3x7dspz3mhxue69uhhyetvv9ujuerpd46hxtnfdupzpq35r7yzkm4te5460u00jz4djcw0qa90zku7739qn7wj4ralhe4zqvzqqqqqqy8gv3qc",
+			want: wantWithURL(wantNostrNevent1qqs9eq7, must(url.Parse("nostr:nevent1qqs9eq76w7h3mmrdw2ycxjvc44a2l0v7yxgnjmt4as7vyl66wu3x7dspz3mhxue69uhhyetvv9ujuerpd46hxtnfdupzpq35r7yzkm4te5460u00jz4djcw0qa90zku7739qn7wj4ralhe4zqvzqqqqqqy8gv3qc"))),
+		},
+		{
+			in:      "https://njump.me/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63n",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://njump.me/npub1SG6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://primal.net/x/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://primal.net/e/npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://primal.net/p/note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://coracle.social/people/nevent1qqs9eq76w7h3mmrdw2ycxjvc44a2l0v7yxgnjmt4as7vyl66wu3x7dspz3mhxue69uhhyetvv9ujuerpd46hxtnfdupzpq35r7yzkm4te5460u00jz4djcw0qa90zku7739qn7wj4ralhe4zqvzqqqqqqy8gv3qc",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://coracle.social/notes/nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://snort.social/e/nprofile1qqsgydql3q4ka27d9wnlrmus4tvkrnc8ftc4h8h5fgyln54gl0a7dgspz3mhxue69uhhyetvv9ujuerpd46hxtnfduqs6amnwvaz7tmwdaejumr0ds8py5ck",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://line.me/ti/p/~hjr265",
+			want: wantWithURL(wantLineHjr265, must(url.Parse("https://line.me/ti/p/~hjr265"))),
+		},
+		{
+			in:   "https://line.me/R/ti/p/@furqansoftware",
+			want: wantWithURL(wantLineAccountFurqanSoftware, must(url.Parse("https://line.me/R/ti/p/@furqansoftware"))),
+		},
+		{
+			in:   "https://line.me/ti/g2/AbCdEfGhIjKlMnOp",
+			want: wantWithURL(wantLineOpenChatAbCdEfGh, must(url.Parse("https://line.me/ti/g2/AbCdEfGhIjKlMnOp"))),
+		},
+		{
+			in:      "https://line.me/ti/x/AbCdEfGh",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://invite.viber.com/?g2=AQBdsjfkHsdf9Y2lN",
+			want: wantWithURL(wantViberCommunityAQBdsjfk, must(url.Parse("https://invite.viber.com/?g2=AQBdsjfkHsdf9Y2lN"))),
+		},
+		{
+			in:   "https://chats.viber.com/furqansoftware",
+			want: wantWithURL(wantViberChannelFurqanSoftware, must(url.Parse("https://chats.viber.com/furqansoftware"))),
+		},
+		{
+			in:   "viber://pa?chatURI=furqansoftware",
+			want: wantWithURL(wantViberChannelFurqanSoftware, must(url.Parse("viber://pa?chatURI=furqansoftware"))),
+		},
+		{
+			in:   "viber://chat?number=%2B8801712345678",
+			want: wantWithURL(wantViber8801712345678, must(url.Parse("viber://chat?number=%2B8801712345678"))),
+		},
+		{
+			in:   "https://invite.viber.com/?g2=AQB+abc/def=",
+			want: wantWithURL(wantViberCommunityAQBAbcDef, must(url.Parse("https://invite.viber.com/?g2=AQB+abc/def="))),
+		},
+		{
+			in:   "https://invite.viber.com/?g2=AQB%2Babc%2Fdef%3D",
+			want: wantWithURL(wantViberCommunityAQBAbcDef, must(url.Parse("https://invite.viber.com/?g2=AQB%2Babc%2Fdef%3D"))),
+		},
+		{
+			in:   "viber://chat?number=+8801712345678",
+			want: wantWithURL(wantViber8801712345678, must(url.Parse("viber://chat?number=+8801712345678"))),
+		},
+		{
+			in:      "https://invite.viber.com/?x=AQBdsjfkHsdf9Y2lN",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://u.wechat.com/kPbYm4ZmgnrFXTb0qoTbV0o",
+			want: wantWithURL(wantWeChatKPbYm4Zm, must(url.Parse("https://u.wechat.com/kPbYm4ZmgnrFXTb0qoTbV0o"))),
+		},
+		{
+			in:   "http://weixin.qq.com/r/kPbYm4ZmgnrFXTb0qoTbV0o",
+			want: wantWithURL(wantWeChatKPbYm4Zm, must(url.Parse("https://weixin.qq.com/r/kPbYm4ZmgnrFXTb0qoTbV0o"))),
+		},
+		{
+			in:   "https://weixin.qq.com/g/A1b2C3d4E5f6G7h8",
+			want: wantWithURL(wantWeChatGroupA1b2C3d4, must(url.Parse("https://weixin.qq.com/g/A1b2C3d4E5f6G7h8"))),
+		},
+		{
+			in:      "https://weixin.qq.com/cgi-bin/readtemplate",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://open.kakao.com/o/gAbCdEfG",
+			want: wantWithURL(wantKakaoTalkOpenChatGAbCdEfG, must(url.Parse("https://open.kakao.com/o/gAbCdEfG"))),
+		},
+		{
+			in:   "https://open.kakao.com/me/hjr265",
+			want: wantWithURL(wantKakaoTalkHjr265, must(url.Parse("https://open.kakao.com/me/hjr265"))),
+		},
+		{
+			in:   "https://pf.kakao.com/_xdxbxaxj",
+			want: wantWithURL(wantKakaoTalkChannelXdxbxaxj, must(url.Parse("https://pf.kakao.com/_xdxbxaxj"))),
+		},
+		{
+			in:      "https://pf.kakao.com/xdxbxaxj",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://stackoverflow.com/users/22656/jon-skeet",
+			want: wantWithURL(wantStackExchangeUser22656, must(url.Parse("https://stackoverflow.com/users/22656/jon-skeet"))),
+		},
+		{
+			in:   "https://stackoverflow.com/u/22656",
+			want: wantWithURL(wantStackExchangeUser22656NoSlug, must(url.Parse("https://stackoverflow.com/u/22656"))),
+		},
+		{
+			in:   "https://stackoverflow.com/questions/11227809/why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array",
+			want: wantWithURL(wantStackExchangeQuestion11227809, must(url.Parse("https://stackoverflow.com/questions/11227809/why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array"))),
+		},
+		{
+			in:   "https://stackoverflow.com/questions/11227809/why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array/11227902",
+			want: wantWithURL(wantStackExchangeAnswer11227902, must(url.Parse("https://stackoverflow.com/questions/11227809/why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array/11227902"))),
+		},
+		{
+			in:   "https://stackoverflow.com/a/11227902/22656",
+			want: wantWithURL(wantStackExchangeAnswer11227902Short, must(url.Parse("https://stackoverflow.com/a/11227902/22656"))),
+		},
+		{
+			in:   "https://math.stackexchange.com/users/1/jeff",
+			want: wantWithURL(wantStackExchangeMathUser1, must(url.Parse("https://math.stackexchange.com/users/1/jeff"))),
+		},
+		{
+			in:   "https://ru.stackoverflow.com/u/1",
+			want: wantWithURL(wantStackExchangeRuUser1, must(url.Parse("https://ru.stackoverflow.com/u/1"))),
+		},
+		{
+			in:      "https://chat.stackexchange.com/users/1",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://chat.stackoverflow.com/users/1",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://stackoverflow.com/questions/tagged/go",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://news.ycombinator.com/user?id=pg",
+			want: wantWithURL(wantHackerNewsPg, must(url.Parse("https://news.ycombinator.com/user?id=pg"))),
+		},
+		{
+			in:   "https://news.ycombinator.com/item?id=1",
+			want: wantWithURL(wantHackerNewsItem1, must(url.Parse("https://news.ycombinator.com/item?id=1"))),
+		},
+		{
+			in:      "https://news.ycombinator.com/item?id=abc",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://news.ycombinator.com/news",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://dev.to/ben",
+			want: wantWithURL(wantDevToBen, must(url.Parse("https://dev.to/ben"))),
+		},
+		{
+			in:   "https://dev.to/ben/welcome-thread-v1-1a2b",
+			want: wantWithURL(wantDevToBenWelcome, must(url.Parse("https://dev.to/ben/welcome-thread-v1-1a2b"))),
+		},
+		{
+			in:      "https://dev.to/t/go",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://hashnode.com/@hjr265",
+			want: wantWithURL(wantHashnodeHjr265, must(url.Parse("https://hashnode.com/@hjr265"))),
+		},
+		{
+			in:   "https://hjr265.hashnode.dev/",
+			want: wantWithURL(wantHashnodeBlogHjr265, must(url.Parse("https://hjr265.hashnode.dev/"))),
+		},
+		{
+			in:   "https://hjr265.hashnode.dev/hello-world",
+			want: wantWithURL(wantHashnodePostHelloWorld, must(url.Parse("https://hjr265.hashnode.dev/hello-world"))),
+		},
+		{
+			in:      "https://api.hashnode.dev/",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://hashnode.com/hjr265",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.kaggle.com/hjr265",
+			want: wantWithURL(wantKaggleHjr265, must(url.Parse("https://www.kaggle.com/hjr265"))),
+		},
+		{
+			in:   "https://www.kaggle.com/competitions/titanic",
+			want: wantWithURL(wantKaggleCompetitionTitanic, must(url.Parse("https://www.kaggle.com/competitions/titanic"))),
+		},
+		{
+			in:   "https://www.kaggle.com/datasets/hjr265/go-repos",
+			want: wantWithURL(wantKaggleDatasetGoRepos, must(url.Parse("https://www.kaggle.com/datasets/hjr265/go-repos"))),
+		},
+		{
+			in:   "https://www.kaggle.com/code/hjr265/go-repos-eda",
+			want: wantWithURL(wantKaggleNotebookGoReposEDA, must(url.Parse("https://www.kaggle.com/code/hjr265/go-repos-eda"))),
+		},
+		{
+			in:      "https://www.kaggle.com/learn",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://leetcode.com/u/hjr265/",
+			want: wantWithURL(wantLeetCodeHjr265, must(url.Parse("https://leetcode.com/u/hjr265/"))),
+		},
+		{
+			in:   "https://leetcode.com/hjr265",
+			want: wantWithURL(wantLeetCodeHjr265, must(url.Parse("https://leetcode.com/hjr265"))),
+		},
+		{
+			in:   "https://leetcode.com/problems/two-sum/description/",
+			want: wantWithURL(wantLeetCodeProblemTwoSum, must(url.Parse("https://leetcode.com/problems/two-sum/description/"))),
+		},
+		{
+			in:      "https://leetcode.com/problemset",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://codeforces.com/profile/tourist",
+			want: wantWithURL(wantCodeforcesTourist, must(url.Parse("https://codeforces.com/profile/tourist"))),
+		},
+		{
+			in:   "https://codeforces.com/contest/1903",
+			want: wantWithURL(wantCodeforcesContest1903, must(url.Parse("https://codeforces.com/contest/1903"))),
+		},
+		{
+			in:   "https://codeforces.com/contest/1903/problem/D2",
+			want: wantWithURL(wantCodeforcesProblem1903D2, must(url.Parse("https://codeforces.com/contest/1903/problem/D2"))),
+		},
+		{
+			in:   "https://codeforces.com/problemset/problem/1903/D2",
+			want: wantWithURL(wantCodeforcesProblem1903D2, must(url.Parse("https://codeforces.com/problemset/problem/1903/D2"))),
+		},
+		{
+			in:   "https://codeforces.com/blog/entry/123456",
+			want: wantWithURL(wantCodeforcesBlogEntry123456, must(url.Parse("https://codeforces.com/blog/entry/123456"))),
+		},
+		{
+			in:      "https://codeforces.com/contest/1903/problem/d2",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://atcoder.jp/users/tourist",
+			want: wantWithURL(wantAtCoderTourist, must(url.Parse("https://atcoder.jp/users/tourist"))),
+		},
+		{
+			in:   "https://atcoder.jp/contests/abc300",
+			want: wantWithURL(wantAtCoderContestABC300, must(url.Parse("https://atcoder.jp/contests/abc300"))),
+		},
+		{
+			in:   "https://atcoder.jp/contests/abc300/tasks/abc300_a",
+			want: wantWithURL(wantAtCoderTaskABC300A, must(url.Parse("https://atcoder.jp/contests/abc300/tasks/abc300_a"))),
+		},
+		{
+			in:      "https://atcoder.jp/users/t",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:   "https://www.hackerrank.com/profile/hjr265",
+			want: wantWithURL(wantHackerRankHjr265, must(url.Parse("https://www.hackerrank.com/profile/hjr265"))),
+		},
+		{
+			in:   "https://www.hackerrank.com/hjr265",
+			want: wantWithURL(wantHackerRankHjr265, must(url.Parse("https://www.hackerrank.com/hjr265"))),
+		},
+		{
+			in:   "https://www.hackerrank.com/challenges/solve-me-first/problem",
+			want: wantWithURL(wantHackerRankChallengeSolveMeFirst, must(url.Parse("https://www.hackerrank.com/challenges/solve-me-first/problem"))),
+		},
+		{
+			in:   "https://www.hackerrank.com/contests/projecteuler",
+			want: wantWithURL(wantHackerRankContestProjectEuler, must(url.Parse("https://www.hackerrank.com/contests/projecteuler"))),
+		},
+		{
+			in:      "https://www.hackerrank.com/dashboard",
+			wantErr: ErrInvalidURL,
+		},
+	} {
+		t.Run(c.in, func(t *testing.T) {
+			got, err := Parse(c.in)
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("want error %q, got %q", c.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(c.want, got) {
+				t.Fatal(cmp.Diff(c.want, got))
+			}
+		})
+	}
+}
+
+var (
+	wantFacebookHjr265 = &URL{
+		Service: Facebook,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantFacebookMahmudrayed152 = &URL{
+		Service: Facebook,
+		Type:    "Profile",
+		ID:      "mahmud.rayed.152",
+		Data: map[string]string{
+			"username": "mahmud.rayed.152",
+		},
+	}
+	wantFLOSSSocialHjr265 = &URL{
+		Service: FLOSSSocial,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+			"platform": "Mastodon",
+		},
+	}
+	wantFosstodonHjr265 = &URL{
+		Service: Fosstodon,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+			"platform": "Mastodon",
+		},
+	}
+	wantGitHubHjr265 = &URL{
+		Service: GitHub,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantLinkedInHjr265 = &URL{
+		Service: LinkedIn,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+		URL: must(url.Parse("https://www.linkedin.com/in/hjr265/")),
+	}
+	wantTelegramHjr265 = &URL{
+		Service: Telegram,
+		Type:    "Account",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantTelegramKeyboardCatPhoneNumber = &URL{
+		Service: Telegram,
+		Type:    "Account",
+		ID:      "+100000000000001",
+		Data: map[string]string{
+			"phoneNumber":    "+100000000000001",
+			"countryCode":    "1",
+			"nationalNumber": "00000000000001",
+		},
+	}
+	wantTwitterHjr265 = &URL{
+		Service: Twitter,
+		Type:    "Account",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantFacebookIAmKeyboardCat = &URL{
+		Service: Facebook,
+		Type:    "Profile",
+		ID:      "I.AM.KEYBOARDCAT",
+		Data: map[string]string{
+			"username": "I.AM.KEYBOARDCAT",
+		},
+	}
+	wantFacebookIAmKeyboardCatProfileID = &URL{
+		Service: Facebook,
+		Type:    "Profile",
+		ID:      "100000000000001",
+		Data: map[string]string{
+			"profileID": "100000000000001",
+		},
+	}
+	wantInstagramIAmKeyboardCat = &URL{
+		Service: Instagram,
+		Type:    "Profile",
+		ID:      "I.AM.KEYBOARDCAT",
+		Data: map[string]string{
+			"username": "I.AM.KEYBOARDCAT",
+		},
+	}
+	wantInstagramRayed152 = &URL{
+		Service: Instagram,
+		Type:    "Profile",
+		ID:      "rayed152",
+		Data: map[string]string{
+			"username": "rayed152",
+		},
+	}
+	wantYouTubeIAmKeyboardCat = &URL{
+		Service: YouTube,
+		Type:    "Channel",
+		ID:      "I-AM_KEYBOARDCAT",
+		Data: map[string]string{
+			"channelID": "I-AM_KEYBOARDCAT",
+		},
+	}
+	wantRedditAcceptableMix8356 = &URL{
+		Service: Reddit,
+		Type:    "User",
+		ID:      "Acceptable-Mix8356",
+		Data: map[string]string{
+			"username": "Acceptable-Mix8356",
+		},
+	}
+	wantSubRedditIdk152 = &URL{
+		Service: Reddit,
+		Type:    "Subreddit",
+		ID:      "idk_1_52",
+		Data: map[string]string{
+			"username": "idk_1_52",
+		},
+	}
+	wantPinterestRayed152 = &URL{
+		Service: Pinterest,
+		Type:    "Profile",
+		ID:      "rayed152",
+		Data: map[string]string{
+			"username": "rayed152",
+		},
+	}
+	wantYouTubeMahmudRayed = &URL{
+		Service: YouTube,
+		Type:    "Channel",
+		ID:      "MahmudRayed",
+		Data: map[string]string{
+			"channelID": "MahmudRayed",
+		},
+	}
+	wantMessengerMahmudRayed = &URL{
+		Service: Messenger,
+		Type:    "User",
+		ID:      "6585231744937052",
+		Data: map[string]string{
+			"username": "6585231744937052",
+		},
+	}
+	wantSnapchatHjr265 = &URL{
+		Service: Snapchat,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSignal1234567890 = &URL{
+		Service: Signal,
+		Type:    "Account",
+		ID:      "+1234567890",
+		Data: map[string]string{
+			"phoneNumber":    "+1234567890",
+			"countryCode":    "1",
+			"nationalNumber": "234567890",
+		},
+	}
+	wantGitLabHjr265 = &URL{
+		Service: GitLab,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantBitbucketHjr265 = &URL{
+		Service: Bitbucket,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantCodebergHjr265 = &URL{
+		Service: Codeberg,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantMediumHjr265 = &URL{
+		Service: Medium,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSubstackHjr265 = &URL{
+		Service: Substack,
+		Type:    "Publication",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantPatreonHjr265 = &URL{
+		Service: Patreon,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantKofiHjr265 = &URL{
+		Service: Kofi,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantDribbbleHjr265 = &URL{
+		Service: Dribbble,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantBehanceHjr265 = &URL{
+		Service: Behance,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantDeviantArtHjr265 = &URL{
+		Service: DeviantArt,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantVimeoHjr265 = &URL{
+		Service: Vimeo,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSoundCloudHjr265 = &URL{
+		Service: SoundCloud,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantBandcampHjr265 = &URL{
+		Service: Bandcamp,
+		Type:    "Artist",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"artist": "hjr265",
+		},
+	}
+	wantLetterboxdHjr265 = &URL{
+		Service: Letterboxd,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSourcehutHjr265 = &URL{
+		Service: Sourcehut,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantGoodreads12345678 = &URL{
+		Service: Goodreads,
+		Type:    "Profile",
+		ID:      "12345678",
+		Data: map[string]string{
+			"userID": "12345678",
+		},
+	}
+	wantKickHjr265 = &URL{
+		Service: Kick,
+		Type:    "Channel",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantMastodonHjr265 = &URL{
+		Service: Mastodon,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+			"platform": "Mastodon",
+		},
+	}
+	wantSpotifyHjr265 = &URL{
+		Service: Spotify,
+		Type:    "User",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantTophHjr265 = &URL{
+		Service: Toph,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"handle": "hjr265",
+		},
+	}
+	wantTumblrHjr265 = &URL{
+		Service: Tumblr,
+		Type:    "Blog",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantTikTokHjr265 = &URL{
+		Service: TikTok,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSteamHjr265 = &URL{
+		Service: Steam,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantThreadsHjr265 = &URL{
+		Service: Threads,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantBlueskyHjr265 = &URL{
+		Service: Bluesky,
+		Type:    "Profile",
+		ID:      "hjr265.bsky.social",
+		Data: map[string]string{
+			"handle": "hjr265.bsky.social",
+		},
+	}
+	wantWhatsApp1234567890 = &URL{
+		Service: WhatsApp,
+		Type:    "Account",
+		ID:      "+1234567890",
+		Data: map[string]string{
+			"phoneNumber":    "+1234567890",
+			"countryCode":    "1",
+			"nationalNumber": "234567890",
+		},
+	}
+	wantTwitchRayed152 = &URL{
+		Service: Twitch,
+		Type:    "Channel",
+		ID:      "rayed152",
+		Data: map[string]string{
+			"username": "rayed152",
+		},
+	}
+	wantVimeoVideo76979871 = &URL{
+		Service: Vimeo,
+		Type:    "Video",
+		ID:      "76979871",
+		Data: map[string]string{
+			"videoID": "76979871",
+		},
+	}
+	wantVimeoVideo76979871Unlisted = &URL{
+		Service: Vimeo,
+		Type:    "Video",
+		ID:      "76979871",
+		Data: map[string]string{
+			"videoID": "76979871",
+			"hash":    "8272103f6e",
+		},
+	}
+	wantVimeoVideo76979871Staffpicks = &URL{
+		Service: Vimeo,
+		Type:    "Video",
+		ID:      "76979871",
+		Data: map[string]string{
+			"videoID": "76979871",
+			"channel": "staffpicks",
+		},
+	}
+	wantVimeoChannelStaffpicks = &URL{
+		Service: Vimeo,
+		Type:    "Channel",
+		ID:      "staffpicks",
+		Data: map[string]string{
+			"channel": "staffpicks",
+		},
+	}
+	wantVimeoShowcase1234567 = &URL{
+		Service: Vimeo,
+		Type:    "Showcase",
+		ID:      "1234567",
+		Data: map[string]string{
+			"showcaseID": "1234567",
+		},
+	}
+	wantVimeoGroupAnimation = &URL{
+		Service: Vimeo,
+		Type:    "Group",
+		ID:      "animation",
+		Data: map[string]string{
+			"group": "animation",
+		},
+	}
+	wantVimeoUser12345 = &URL{
+		Service: Vimeo,
+		Type:    "Profile",
+		ID:      "user12345",
+		Data: map[string]string{
+			"username": "user12345",
+			"userID":   "12345",
+		},
+	}
+	wantTelegramHjr265Post42 = &URL{
+		Service: Telegram,
+		Type:    "Post",
+		ID:      "hjr265/42",
+		Data: map[string]string{
+			"username": "hjr265",
+			"postID":   "42",
+		},
+	}
+	wantTelegramInviteAbCdEf = &URL{
+		Service: Telegram,
+		Type:    "Invite",
+		ID:      "AbCdEf0123456789",
+		Data: map[string]string{
+			"inviteHash": "AbCdEf0123456789",
+		},
+	}
+	wantTelegramInviteAbCdEfShort = &URL{
+		Service: Telegram,
+		Type:    "Invite",
+		ID:      "AbCdEf",
+		Data: map[string]string{
+			"inviteHash": "AbCdEf",
+		},
+	}
+	wantTelegramStickerSetAnimals = &URL{
+		Service: Telegram,
+		Type:    "StickerSet",
+		ID:      "Animals",
+		Data: map[string]string{
+			"stickerSet": "Animals",
+		},
+	}
+	wantWhatsApp1234567890HelloThere = &URL{
+		Service: WhatsApp,
+		Type:    "Account",
+		ID:      "+1234567890",
+		Data: map[string]string{
+			"phoneNumber":    "+1234567890",
+			"countryCode":    "1",
+			"nationalNumber": "234567890",
+			"text":           "Hello there!",
+		},
+	}
+	wantWhatsAppGroupAbCdEf = &URL{
+		Service: WhatsApp,
+		Type:    "Group",
+		ID:      "AbCdEfGhIjKlMnOpQrStUv",
+		Data: map[string]string{
+			"inviteCode": "AbCdEfGhIjKlMnOpQrStUv",
+		},
+	}
+	wantWhatsAppChannel0029Va = &URL{
+		Service: WhatsApp,
+		Type:    "Channel",
+		ID:      "0029VaAbCdEfGhIjKlMnOpQr",
+		Data: map[string]string{
+			"channelID": "0029VaAbCdEfGhIjKlMnOpQr",
+		},
+	}
+	wantSignalUsername = &URL{
+		Service: Signal,
+		Type:    "Username",
+		ID:      "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8BI0VniavN7wEjRWeJq83v",
+		Data: map[string]string{
+			"serverID": "01234567-89ab-cdef-0123-456789abcdef",
+		},
+	}
+	wantSignalGroup = &URL{
+		Service: Signal,
+		Type:    "Group",
+		ID:      "CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX",
+		Data: map[string]string{
+			"inviteCode": "CjQKIGRlZmdoaWprbG1ub3BxcnN0dXZ3eHl6e3x9fn-AgYKDEhDIycrLzM3Oz9DR0tPU1dbX",
+		},
+	}
+	wantBlueskyDID = &URL{
+		Service: Bluesky,
+		Type:    "Profile",
+		ID:      "did:plc:z72i7hdynmk6r22z27h6tvur",
+		Data: map[string]string{
+			"did": "did:plc:z72i7hdynmk6r22z27h6tvur",
+		},
+	}
+	wantBlueskyHjr265Post = &URL{
+		Service: Bluesky,
+		Type:    "Post",
+		ID:      "hjr265.bsky.social/3k2a4xyzabc2y",
+		Data: map[string]string{
+			"handle": "hjr265.bsky.social",
+			"rkey":   "3k2a4xyzabc2y",
+		},
+	}
+	wantBlueskyDIDFeed = &URL{
+		Service: Bluesky,
+		Type:    "Feed",
+		ID:      "did:plc:z72i7hdynmk6r22z27h6tvur/whats-hot",
+		Data: map[string]string{
+			"did":  "did:plc:z72i7hdynmk6r22z27h6tvur",
+			"rkey": "whats-hot",
+		},
+	}
+	wantBlueskyDIDList = &URL{
+		Service: Bluesky,
+		Type:    "List",
+		ID:      "did:plc:z72i7hdynmk6r22z27h6tvur/3k2a4xyzabc2y",
+		Data: map[string]string{
+			"did":  "did:plc:z72i7hdynmk6r22z27h6tvur",
+			"rkey": "3k2a4xyzabc2y",
+		},
+	}
+	wantBlueskyHjr265ExampleCom = &URL{
+		Service: Bluesky,
+		Type:    "Profile",
+		ID:      "hjr265.example.com",
+		Data: map[string]string{
+			"handle": "hjr265.example.com",
+		},
+	}
+	wantBlueskyBucherDe = &URL{
+		Service: Bluesky,
+		Type:    "Profile",
+		ID:      "xn--bcher-kva.de",
+		Data: map[string]string{
+			"handle": "xn--bcher-kva.de",
+		},
+	}
+	wantThreadsHjr265Post = &URL{
+		Service: Threads,
+		Type:    "Post",
+		ID:      "C8aBcD_eF-g",
+		Data: map[string]string{
+			"username": "hjr265",
+			"postCode": "C8aBcD_eF-g",
+		},
+	}
+	wantMediumPublicationHjr265 = &URL{
+		Service: Medium,
+		Type:    "Publication",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"publication": "hjr265",
+		},
+	}
+	wantMediumFooBar = &URL{
+		Service: Medium,
+		Type:    "Profile",
+		ID:      "foo-bar",
+		Data: map[string]string{
+			"username": "foo-bar",
+		},
+	}
+	wantMediumHjr265Story = &URL{
+		Service: Medium,
+		Type:    "Story",
+		ID:      "1a2b3c4d5e6f",
+		Data: map[string]string{
+			"username": "hjr265",
+			"slug":     "writing-a-url-parser-in-go",
+			"postID":   "1a2b3c4d5e6f",
+		},
+	}
+	wantMediumFurqanSoftwareStory = &URL{
+		Service: Medium,
+		Type:    "Story",
+		ID:      "1a2b3c4d5e6f",
+		Data: map[string]string{
+			"publication": "furqan-software",
+			"slug":        "writing-a-url-parser-in-go",
+			"postID":      "1a2b3c4d5e6f",
+		},
+	}
+	wantMediumStory1a2b3c4d5e6f = &URL{
+		Service: Medium,
+		Type:    "Story",
+		ID:      "1a2b3c4d5e6f",
+		Data: map[string]string{
+			"postID": "1a2b3c4d5e6f",
+		},
+	}
+	wantSubstackHjr265Post = &URL{
+		Service: Substack,
+		Type:    "Post",
+		ID:      "hjr265/writing-a-url-parser",
+		Data: map[string]string{
+			"username": "hjr265",
+			"slug":     "writing-a-url-parser",
+		},
+	}
+	wantSubstackHjr265Podcast = &URL{
+		Service: Substack,
+		Type:    "Podcast",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSubstackHjr265Profile = &URL{
+		Service: Substack,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSubstackHjr265Note = &URL{
+		Service: Substack,
+		Type:    "Note",
+		ID:      "c-12345678",
+		Data: map[string]string{
+			"username": "hjr265",
+			"noteID":   "c-12345678",
+		},
+	}
+	wantBandcampHjr265AlbumFirstLight = &URL{
+		Service: Bandcamp,
+		Type:    "Album",
+		ID:      "hjr265/first-light",
+		Data: map[string]string{
+			"artist": "hjr265",
+			"album":  "first-light",
+		},
+	}
+	wantBandcampHjr265TrackDawn = &URL{
+		Service: Bandcamp,
+		Type:    "Track",
+		ID:      "hjr265/dawn",
+		Data: map[string]string{
+			"artist": "hjr265",
+			"track":  "dawn",
+		},
+	}
+	wantBandcampHjr265Merch = &URL{
+		Service: Bandcamp,
+		Type:    "Merch",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"artist": "hjr265",
+		},
+	}
+	wantBandcampHjr265MerchTShirt = &URL{
+		Service: Bandcamp,
+		Type:    "Merch",
+		ID:      "hjr265/first-light-t-shirt",
+		Data: map[string]string{
+			"artist": "hjr265",
+			"merch":  "first-light-t-shirt",
+		},
+	}
+	wantBandcampFanHjr265 = &URL{
+		Service: Bandcamp,
+		Type:    "Fan",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSoundCloudHjr265TrackNightDrive = &URL{
+		Service: SoundCloud,
+		Type:    "Track",
+		ID:      "hjr265/night-drive",
+		Data: map[string]string{
+			"username": "hjr265",
+			"track":    "night-drive",
+		},
+	}
+	wantSoundCloudHjr265PlaylistLateHours = &URL{
+		Service: SoundCloud,
+		Type:    "Playlist",
+		ID:      "hjr265/late-hours",
+		Data: map[string]string{
+			"username": "hjr265",
+			"playlist": "late-hours",
+		},
+	}
+	wantSoundCloudHjr265Likes = &URL{
+		Service: SoundCloud,
+		Type:    "Likes",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSoundCloudHjr265Reposts = &URL{
+		Service: SoundCloud,
+		Type:    "Reposts",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSoundCloudShortLinkaB3dE5fG7h = &URL{
+		Service: SoundCloud,
+		Type:    "ShortLink",
+		ID:      "aB3dE5fG7h",
+		Data: map[string]string{
+			"code": "aB3dE5fG7h",
+		},
+	}
+	wantPinterestRayed152BoardTravelIdeas = &URL{
+		Service: Pinterest,
+		Type:    "Board",
+		ID:      "rayed152/travel-ideas",
+		Data: map[string]string{
+			"username": "rayed152",
+			"board":    "travel-ideas",
+		},
+	}
+	wantPinterestPin99360735500167749 = &URL{
+		Service: Pinterest,
+		Type:    "Pin",
+		ID:      "99360735500167749",
+		Data: map[string]string{
+			"pinID": "99360735500167749",
+		},
+	}
+	wantPinterestShortLink4GxQk9Zb = &URL{
+		Service: Pinterest,
+		Type:    "ShortLink",
+		ID:      "4GxQk9Zb",
+		Data: map[string]string{
+			"code": "4GxQk9Zb",
+		},
+	}
+	wantGoodreads12345678John = &URL{
+		Service: Goodreads,
+		Type:    "Profile",
+		ID:      "12345678",
+		Data: map[string]string{
+			"userID": "12345678",
+			"slug":   "john",
+		},
+	}
+	wantGoodreadsHjr265 = &URL{
+		Service: Goodreads,
+		Type:    "Profile",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantGoodreadsAuthor1077326 = &URL{
+		Service: Goodreads,
+		Type:    "Author",
+		ID:      "1077326",
+		Data: map[string]string{
+			"authorID": "1077326",
+			"slug":     "J_K_Rowling",
+		},
+	}
+	wantGoodreadsBook2767052 = &URL{
+		Service: Goodreads,
+		Type:    "Book",
+		ID:      "2767052",
+		Data: map[string]string{
+			"bookID": "2767052",
+			"slug":   "the-hunger-games",
+		},
+	}
+	wantGoodreadsSeries73758 = &URL{
+		Service: Goodreads,
+		Type:    "Series",
+		ID:      "73758",
+		Data: map[string]string{
+			"seriesID": "73758",
+			"slug":     "the-hunger-games",
+		},
+	}
+	wantGoodreadsGroup1865 = &URL{
+		Service: Goodreads,
+		Type:    "Group",
+		ID:      "1865",
+		Data: map[string]string{
+			"groupID": "1865",
+		},
+	}
+	wantLetterboxdFilmTheGodfather = &URL{
+		Service: Letterboxd,
+		Type:    "Film",
+		ID:      "the-godfather",
+		Data: map[string]string{
+			"film": "the-godfather",
+		},
+	}
+	wantLetterboxdHjr265ListFavourites = &URL{
+		Service: Letterboxd,
+		Type:    "List",
+		ID:      "hjr265/favourites",
+		Data: map[string]string{
+			"username": "hjr265",
+			"list":     "favourites",
+		},
+	}
+	wantLetterboxdHjr265ReviewTheGodfather = &URL{
+		Service: Letterboxd,
+		Type:    "Review",
+		ID:      "hjr265/the-godfather",
+		Data: map[string]string{
+			"username": "hjr265",
+			"film":     "the-godfather",
+		},
+	}
+	wantLetterboxdHjr265Diary = &URL{
+		Service: Letterboxd,
+		Type:    "Diary",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantLetterboxdShortLink2bZk = &URL{
+		Service: Letterboxd,
+		Type:    "ShortLink",
+		ID:      "2bZk",
+		Data: map[string]string{
+			"code": "2bZk",
+		},
+	}
+	wantKickHjr265Video6f1c2a3b = &URL{
+		Service: Kick,
+		Type:    "Video",
+		ID:      "6f1c2a3b-4d5e-4f60-8a7b-9c0d1e2f3a4b",
+		Data: map[string]string{
+			"username": "hjr265",
+			"videoID":  "6f1c2a3b-4d5e-4f60-8a7b-9c0d1e2f3a4b",
 		},
 	}
-	wantBehanceHjr265 = &URL{
-		Service: Behance,
-		Type:    "Profile",
+	wantKickHjr265ClipClip01HQ5ZP8K3V6X9 = &URL{
+		Service: Kick,
+		Type:    "Clip",
+		ID:      "clip_01HQ5ZP8K3V6X9",
+		Data: map[string]string{
+			"username": "hjr265",
+			"clipID":   "clip_01HQ5ZP8K3V6X9",
+		},
+	}
+	wantKofiHjr265Shop = &URL{
+		Service: Kofi,
+		Type:    "Shop",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantDeviantArtHjr265 = &URL{
-		Service: DeviantArt,
-		Type:    "Profile",
+	wantKofiHjr265Commissions = &URL{
+		Service: Kofi,
+		Type:    "Commissions",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantVimeoHjr265 = &URL{
-		Service: Vimeo,
+	wantKofiShopItemA1b2c3d4e5 = &URL{
+		Service: Kofi,
+		Type:    "ShopItem",
+		ID:      "a1b2c3d4e5",
+		Data: map[string]string{
+			"itemID": "a1b2c3d4e5",
+		},
+	}
+	wantKofiCommissionF6e5d4c3b2 = &URL{
+		Service: Kofi,
+		Type:    "Commission",
+		ID:      "f6e5d4c3b2",
+		Data: map[string]string{
+			"commissionID": "f6e5d4c3b2",
+		},
+	}
+	wantKofiPostQ5Q8ABCDE = &URL{
+		Service: Kofi,
+		Type:    "Post",
+		ID:      "Q5Q8ABCDE",
+		Data: map[string]string{
+			"slug":   "New-Album-Out-Now",
+			"postID": "Q5Q8ABCDE",
+		},
+	}
+	wantPatreonPost98765432 = &URL{
+		Service: Patreon,
+		Type:    "Post",
+		ID:      "98765432",
+		Data: map[string]string{
+			"postID": "98765432",
+			"slug":   "new-album-out-now",
+		},
+	}
+	wantPatreonUser12345678 = &URL{
+		Service: Patreon,
 		Type:    "Profile",
+		ID:      "12345678",
+		Data: map[string]string{
+			"userID": "12345678",
+		},
+	}
+	wantPatreonJoinHjr265 = &URL{
+		Service: Patreon,
+		Type:    "Join",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantSoundCloudHjr265 = &URL{
-		Service: SoundCloud,
-		Type:    "Profile",
+	wantPatreonJoinCampaign4567890 = &URL{
+		Service: Patreon,
+		Type:    "Join",
+		ID:      "4567890",
+		Data: map[string]string{
+			"campaignID": "4567890",
+		},
+	}
+	wantPatreonCheckoutHjr265 = &URL{
+		Service: Patreon,
+		Type:    "Checkout",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
+			"rewardID": "1122334",
 		},
 	}
-	wantBandcampHjr265 = &URL{
-		Service: Bandcamp,
+	wantBehanceProject187654321 = &URL{
+		Service: Behance,
+		Type:    "Project",
+		ID:      "187654321",
+		Data: map[string]string{
+			"projectID": "187654321",
+			"slug":      "Brand-Identity",
+		},
+	}
+	wantDribbbleShot23456789 = &URL{
+		Service: Dribbble,
+		Type:    "Shot",
+		ID:      "23456789",
+		Data: map[string]string{
+			"shotID": "23456789",
+			"slug":   "Mobile-App-Concept",
+		},
+	}
+	wantDeviantArtHjr265Deviation987654321 = &URL{
+		Service: DeviantArt,
+		Type:    "Deviation",
+		ID:      "987654321",
+		Data: map[string]string{
+			"username":    "hjr265",
+			"deviationID": "987654321",
+			"slug":        "Night-Sky",
+		},
+	}
+	wantSnapchatHjr265Story = &URL{
+		Service: Snapchat,
+		Type:    "Story",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantSnapchatShortLinkXy7kQ2pL = &URL{
+		Service: Snapchat,
+		Type:    "ShortLink",
+		ID:      "Xy7kQ2pL",
+		Data: map[string]string{
+			"code": "Xy7kQ2pL",
+		},
+	}
+	wantSnapchatSpotlightW7EDlXWTBi = &URL{
+		Service: Snapchat,
+		Type:    "Spotlight",
+		ID:      "W7_EDlXWTBiXAEEniNoMPwAAYdGVxbWpxZmN0AYtJ2ZbZAYtJ2ZbGAAAAAQ",
+		Data: map[string]string{
+			"spotlightID": "W7_EDlXWTBiXAEEniNoMPwAAYdGVxbWpxZmN0AYtJ2ZbZAYtJ2ZbGAAAAAQ",
+		},
+	}
+	wantSnapchatLens5b9f2e1c = &URL{
+		Service: Snapchat,
+		Type:    "Lens",
+		ID:      "5b9f2e1c8d7a4b3e9f0c1d2e3f4a5b6c",
+		Data: map[string]string{
+			"lensID": "5b9f2e1c8d7a4b3e9f0c1d2e3f4a5b6c",
+		},
+	}
+	wantDiscordInviteGolang = &URL{
+		Service: Discord,
+		Type:    "Invite",
+		ID:      "golang",
+		Data: map[string]string{
+			"inviteCode": "golang",
+		},
+	}
+	wantDiscordServer118456055842734083 = &URL{
+		Service: Discord,
+		Type:    "Server",
+		ID:      "118456055842734083",
+		Data: map[string]string{
+			"guildID": "118456055842734083",
+			"slug":    "gophers",
+		},
+	}
+	wantDiscordUser175928689082269696 = &URL{
+		Service: Discord,
+		Type:    "User",
+		ID:      "175928689082269696",
+		Data: map[string]string{
+			"userID": "175928689082269696",
+		},
+	}
+	wantDiscordChannel118456055842734083 = &URL{
+		Service: Discord,
+		Type:    "Channel",
+		ID:      "118456055842734083",
+		Data: map[string]string{
+			"guildID":   "118456055842734083",
+			"channelID": "118456055842734083",
+		},
+	}
+	wantDiscordMessage1153012345678901248 = &URL{
+		Service: Discord,
+		Type:    "Message",
+		ID:      "1153012345678901248",
+		Data: map[string]string{
+			"guildID":   "118456055842734083",
+			"channelID": "118456055842734083",
+			"messageID": "1153012345678901248",
+		},
+	}
+	wantDiscordDirectMessage1153012345678901248 = &URL{
+		Service: Discord,
+		Type:    "Message",
+		ID:      "1153012345678901248",
+		Data: map[string]string{
+			"channelID": "931234567890123456",
+			"messageID": "1153012345678901248",
+		},
+	}
+	wantSlackGophers = &URL{
+		Service: Slack,
+		Type:    "Workspace",
+		ID:      "gophers",
+		Data: map[string]string{
+			"workspace": "gophers",
+		},
+	}
+	wantSlackGophersChannelC029RQSEE = &URL{
+		Service: Slack,
+		Type:    "Channel",
+		ID:      "C029RQSEE",
+		Data: map[string]string{
+			"workspace": "gophers",
+			"channelID": "C029RQSEE",
+		},
+	}
+	wantSlackGophersMessage1700000000123456 = &URL{
+		Service: Slack,
+		Type:    "Message",
+		ID:      "C029RQSEE/1700000000.123456",
+		Data: map[string]string{
+			"workspace": "gophers",
+			"channelID": "C029RQSEE",
+			"messageTS": "1700000000.123456",
+		},
+	}
+	wantSlackGophersInvite = &URL{
+		Service: Slack,
+		Type:    "Invite",
+		ID:      "zt-1abc2def3-AbCdEf_gHiJ",
+		Data: map[string]string{
+			"workspace":  "gophers",
+			"inviteCode": "zt-1abc2def3-AbCdEf_gHiJ",
+		},
+	}
+	wantMatrixHjr265 = &URL{
+		Service: Matrix,
+		Type:    "User",
+		ID:      "@hjr265:matrix.org",
+		Data: map[string]string{
+			"userID":    "@hjr265:matrix.org",
+			"localpart": "hjr265",
+			"server":    "matrix.org",
+		},
+	}
+	wantMatrixRoomGoLang = &URL{
+		Service: Matrix,
+		Type:    "Room",
+		ID:      "#go-lang:matrix.org",
+		Data: map[string]string{
+			"roomAlias": "#go-lang:matrix.org",
+			"localpart": "go-lang",
+			"server":    "matrix.org",
+		},
+	}
+	wantMatrixHjr265ExampleCom8448 = &URL{
+		Service: Matrix,
+		Type:    "User",
+		ID:      "@hjr265:example.com:8448",
+		Data: map[string]string{
+			"userID":    "@hjr265:example.com:8448",
+			"localpart": "hjr265",
+			"server":    "example.com:8448",
+		},
+	}
+	wantMatrixHjr265Localhost8448 = &URL{
+		Service: Matrix,
+		Type:    "User",
+		ID:      "@hjr265:localhost:8448",
+		Data: map[string]string{
+			"userID":    "@hjr265:localhost:8448",
+			"localpart": "hjr265",
+			"server":    "localhost:8448",
+		},
+	}
+	wantMatrixHjr265IPv6 = &URL{
+		Service: Matrix,
+		Type:    "User",
+		ID:      "@hjr265:[2001:db8::1]:8448",
+		Data: map[string]string{
+			"userID":    "@hjr265:[2001:db8::1]:8448",
+			"localpart": "hjr265",
+			"server":    "[2001:db8::1]:8448",
+		},
+	}
+	wantMatrixRoomOGEhHVWSdvArJzumhm = &URL{
+		Service: Matrix,
+		Type:    "Room",
+		ID:      "!OGEhHVWSdvArJzumhm:matrix.org",
+		Data: map[string]string{
+			"roomID": "!OGEhHVWSdvArJzumhm:matrix.org",
+			"server": "matrix.org",
+			"via":    "matrix.org,example.com",
+		},
+	}
+	wantMatrixEventBMNXxIl5 = &URL{
+		Service: Matrix,
+		Type:    "Event",
+		ID:      "$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+		Data: map[string]string{
+			"roomID":  "!OGEhHVWSdvArJzumhm:matrix.org",
+			"eventID": "$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+			"via":     "matrix.org",
+		},
+	}
+	wantMatrixEventBMNXxIl5GoLang = &URL{
+		Service: Matrix,
+		Type:    "Event",
+		ID:      "$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+		Data: map[string]string{
+			"roomAlias": "#go-lang:matrix.org",
+			"eventID":   "$bMNXxIl5RPBQvU3dmNrNL5-Mk-EGVAL4lONajGmbI2Y",
+		},
+	}
+	wantKeybaseHjr265 = &URL{
+		Service: Keybase,
 		Type:    "Profile",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantLetterboxdHjr265 = &URL{
-		Service: Letterboxd,
+	wantKeybaseTeamFurqanDev = &URL{
+		Service: Keybase,
+		Type:    "Team",
+		ID:      "furqan.dev",
+		Data: map[string]string{
+			"team": "furqan.dev",
+		},
+	}
+	wantNostrNpub1sg6plzp = &URL{
+		Service: Nostr,
+		Type:    "Profile",
+		ID:      "82341f882b6eabcd2ba7f1ef90aad961cf074af15b9ef44a09f9d2a8fbfbe6a2",
+		Data: map[string]string{
+			"pubkey": "82341f882b6eabcd2ba7f1ef90aad961cf074af15b9ef44a09f9d2a8fbfbe6a2",
+			"npub":   "npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+		},
+	}
+	wantNostrNprofile1qqsgydq = &URL{
+		Service: Nostr,
+		Type:    "Profile",
+		ID:      "82341f882b6eabcd2ba7f1ef90aad961cf074af15b9ef44a09f9d2a8fbfbe6a2",
+		Data: map[string]string{
+			"pubkey": "82341f882b6eabcd2ba7f1ef90aad961cf074af15b9ef44a09f9d2a8fbfbe6a2",
+			"npub":   "npub1sg6plzptd64u62a878hep2kev88swjh3tw00gjsfl8f237lmu63q0uf63m",
+			"relays": "wss://relay.damus.io,wss://nos.lol",
+		},
+	}
+	wantNostrNote1tjpa5aa = &URL{
+		Service: Nostr,
+		Type:    "Note",
+		ID:      "5c83da77af1dec6d7289834998ad7aafbd9e2191396d75ec3cc27f5a77226f36",
+		Data: map[string]string{
+			"eventID": "5c83da77af1dec6d7289834998ad7aafbd9e2191396d75ec3cc27f5a77226f36",
+			"note":    "note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy",
+		},
+	}
+	wantNostrNevent1qqs9eq7 = &URL{
+		Service: Nostr,
+		Type:    "Note",
+		ID:      "5c83da77af1dec6d7289834998ad7aafbd9e2191396d75ec3cc27f5a77226f36",
+		Data: map[string]string{
+			"eventID": "5c83da77af1dec6d7289834998ad7aafbd9e2191396d75ec3cc27f5a77226f36",
+			"note":    "note1tjpa5aa0rhkx6u5fsdye3tt6477eugv389khtmpucfl45aezdumqkkhgwy",
+			"relays":  "wss://relay.damus.io",
+			"author":  "82341f882b6eabcd2ba7f1ef90aad961cf074af15b9ef44a09f9d2a8fbfbe6a2",
+			"kind":    "1",
+		},
+	}
+	wantLineHjr265 = &URL{
+		Service: Line,
+		Type:    "Profile",
+		ID:      "~hjr265",
+		Data: map[string]string{
+			"lineID": "hjr265",
+		},
+	}
+	wantLineAccountFurqanSoftware = &URL{
+		Service: Line,
+		Type:    "Profile",
+		ID:      "@furqansoftware",
+		Data: map[string]string{
+			"accountID": "furqansoftware",
+		},
+	}
+	wantLineOpenChatAbCdEfGh = &URL{
+		Service: Line,
+		Type:    "OpenChat",
+		ID:      "AbCdEfGhIjKlMnOp",
+		Data: map[string]string{
+			"code": "AbCdEfGhIjKlMnOp",
+		},
+	}
+	wantViberCommunityAQBdsjfk = &URL{
+		Service: Viber,
+		Type:    "Community",
+		ID:      "AQBdsjfkHsdf9Y2lN",
+		Data: map[string]string{
+			"inviteCode": "AQBdsjfkHsdf9Y2lN",
+		},
+	}
+	wantViberCommunityAQBAbcDef = &URL{
+		Service: Viber,
+		Type:    "Community",
+		ID:      "AQB+abc/def=",
+		Data: map[string]string{
+			"inviteCode": "AQB+abc/def=",
+		},
+	}
+	wantViberChannelFurqanSoftware = &URL{
+		Service: Viber,
+		Type:    "Channel",
+		ID:      "furqansoftware",
+		Data: map[string]string{
+			"channel": "furqansoftware",
+		},
+	}
+	wantViber8801712345678 = &URL{
+		Service: Viber,
+		Type:    "Account",
+		ID:      "+8801712345678",
+		Data: map[string]string{
+			"phoneNumber":    "+8801712345678",
+			"countryCode":    "880",
+			"nationalNumber": "1712345678",
+		},
+	}
+	wantWeChatKPbYm4Zm = &URL{
+		Service: WeChat,
+		Type:    "Profile",
+		ID:      "kPbYm4ZmgnrFXTb0qoTbV0o",
+		Data: map[string]string{
+			"code": "kPbYm4ZmgnrFXTb0qoTbV0o",
+		},
+	}
+	wantWeChatGroupA1b2C3d4 = &URL{
+		Service: WeChat,
+		Type:    "Group",
+		ID:      "A1b2C3d4E5f6G7h8",
+		Data: map[string]string{
+			"code": "A1b2C3d4E5f6G7h8",
+		},
+	}
+	wantKakaoTalkOpenChatGAbCdEfG = &URL{
+		Service: KakaoTalk,
+		Type:    "OpenChat",
+		ID:      "gAbCdEfG",
+		Data: map[string]string{
+			"code": "gAbCdEfG",
+		},
+	}
+	wantKakaoTalkHjr265 = &URL{
+		Service: KakaoTalk,
 		Type:    "Profile",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantSourcehutHjr265 = &URL{
-		Service: Sourcehut,
+	wantKakaoTalkChannelXdxbxaxj = &URL{
+		Service: KakaoTalk,
+		Type:    "Channel",
+		ID:      "_xdxbxaxj",
+		Data: map[string]string{
+			"channelID": "_xdxbxaxj",
+		},
+	}
+	wantStackExchangeUser22656 = &URL{
+		Service: StackExchange,
+		Type:    "User",
+		ID:      "stackoverflow.com/22656",
+		Data: map[string]string{
+			"site":   "stackoverflow.com",
+			"userID": "22656",
+			"slug":   "jon-skeet",
+		},
+	}
+	wantStackExchangeUser22656NoSlug = &URL{
+		Service: StackExchange,
+		Type:    "User",
+		ID:      "stackoverflow.com/22656",
+		Data: map[string]string{
+			"site":   "stackoverflow.com",
+			"userID": "22656",
+		},
+	}
+	wantStackExchangeQuestion11227809 = &URL{
+		Service: StackExchange,
+		Type:    "Question",
+		ID:      "stackoverflow.com/11227809",
+		Data: map[string]string{
+			"site":       "stackoverflow.com",
+			"questionID": "11227809",
+			"slug":       "why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array",
+		},
+	}
+	wantStackExchangeAnswer11227902 = &URL{
+		Service: StackExchange,
+		Type:    "Answer",
+		ID:      "stackoverflow.com/11227902",
+		Data: map[string]string{
+			"site":       "stackoverflow.com",
+			"answerID":   "11227902",
+			"questionID": "11227809",
+			"slug":       "why-is-processing-a-sorted-array-faster-than-processing-an-unsorted-array",
+		},
+	}
+	wantStackExchangeAnswer11227902Short = &URL{
+		Service: StackExchange,
+		Type:    "Answer",
+		ID:      "stackoverflow.com/11227902",
+		Data: map[string]string{
+			"site":     "stackoverflow.com",
+			"answerID": "11227902",
+			"sharerID": "22656",
+		},
+	}
+	wantStackExchangeMathUser1 = &URL{
+		Service: StackExchange,
+		Type:    "User",
+		ID:      "math.stackexchange.com/1",
+		Data: map[string]string{
+			"site":   "math.stackexchange.com",
+			"userID": "1",
+			"slug":   "jeff",
+		},
+	}
+	wantStackExchangeRuUser1 = &URL{
+		Service: StackExchange,
 		Type:    "User",
+		ID:      "ru.stackoverflow.com/1",
+		Data: map[string]string{
+			"site":   "ru.stackoverflow.com",
+			"userID": "1",
+		},
+	}
+	wantHackerNewsPg = &URL{
+		Service: HackerNews,
+		Type:    "Profile",
+		ID:      "pg",
+		Data: map[string]string{
+			"username": "pg",
+		},
+	}
+	wantHackerNewsItem1 = &URL{
+		Service: HackerNews,
+		Type:    "Item",
+		ID:      "1",
+		Data: map[string]string{
+			"itemID": "1",
+		},
+	}
+	wantDevToBen = &URL{
+		Service: DevTo,
+		Type:    "Profile",
+		ID:      "ben",
+		Data: map[string]string{
+			"username": "ben",
+		},
+	}
+	wantDevToBenWelcome = &URL{
+		Service: DevTo,
+		Type:    "Article",
+		ID:      "ben/welcome-thread-v1-1a2b",
+		Data: map[string]string{
+			"username": "ben",
+			"slug":     "welcome-thread-v1-1a2b",
+		},
+	}
+	wantHashnodeHjr265 = &URL{
+		Service: Hashnode,
+		Type:    "Profile",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
 		},
 	}
-	wantGoodreads12345678 = &URL{
-		Service: Goodreads,
+	wantHashnodeBlogHjr265 = &URL{
+		Service: Hashnode,
+		Type:    "Blog",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"blog": "hjr265",
+		},
+	}
+	wantHashnodePostHelloWorld = &URL{
+		Service: Hashnode,
+		Type:    "Post",
+		ID:      "hjr265/hello-world",
+		Data: map[string]string{
+			"blog": "hjr265",
+			"slug": "hello-world",
+		},
+	}
+	wantKaggleHjr265 = &URL{
+		Service: Kaggle,
 		Type:    "Profile",
-		ID:      "12345678",
+		ID:      "hjr265",
+		Data: map[string]string{
+			"username": "hjr265",
+		},
+	}
+	wantKaggleCompetitionTitanic = &URL{
+		Service: Kaggle,
+		Type:    "Competition",
+		ID:      "titanic",
+		Data: map[string]string{
+			"competition": "titanic",
+		},
+	}
+	wantKaggleDatasetGoRepos = &URL{
+		Service: Kaggle,
+		Type:    "Dataset",
+		ID:      "hjr265/go-repos",
 		Data: map[string]string{
-			"userID": "12345678",
+			"username": "hjr265",
+			"dataset":  "go-repos",
 		},
 	}
-	wantKickHjr265 = &URL{
-		Service: Kick,
-		Type:    "Channel",
-		ID:      "hjr265",
+	wantKaggleNotebookGoReposEDA = &URL{
+		Service: Kaggle,
+		Type:    "Notebook",
+		ID:      "hjr265/go-repos-eda",
 		Data: map[string]string{
 			"username": "hjr265",
+			"notebook": "go-repos-eda",
 		},
 	}
-	wantMastodonHjr265 = &URL{
-		Service: Mastodon,
+	wantLeetCodeHjr265 = &URL{
+		Service: LeetCode,
 		Type:    "Profile",
 		ID:      "hjr265",
 		Data: map[string]string{
 			"username": "hjr265",
-			"platform": "Mastodon",
 		},
 	}
-	wantSpotifyHjr265 = &URL{
-		Service: Spotify,
-		Type:    "User",
-		ID:      "hjr265",
+	wantLeetCodeProblemTwoSum = &URL{
+		Service: LeetCode,
+		Type:    "Problem",
+		ID:      "two-sum",
 		Data: map[string]string{
-			"username": "hjr265",
+			"problem": "two-sum",
 		},
 	}
-	wantTophHjr265 = &URL{
-		Service: Toph,
+	wantCodeforcesTourist = &URL{
+		Service: Codeforces,
 		Type:    "Profile",
-		ID:      "hjr265",
+		ID:      "tourist",
 		Data: map[string]string{
-			"handle": "hjr265",
+			"username": "tourist",
 		},
 	}
-	wantTumblrHjr265 = &URL{
-		Service: Tumblr,
-		Type:    "Blog",
-		ID:      "hjr265",
+	wantCodeforcesContest1903 = &URL{
+		Service: Codeforces,
+		Type:    "Contest",
+		ID:      "1903",
 		Data: map[string]string{
-			"username": "hjr265",
+			"contestID": "1903",
 		},
 	}
-	wantTikTokHjr265 = &URL{
-		Service: TikTok,
-		Type:    "Profile",
-		ID:      "hjr265",
+	wantCodeforcesProblem1903D2 = &URL{
+		Service: Codeforces,
+		Type:    "Problem",
+		ID:      "1903/D2",
 		Data: map[string]string{
-			"username": "hjr265",
+			"contestID": "1903",
+			"index":     "D2",
 		},
 	}
-	wantSteamHjr265 = &URL{
-		Service: Steam,
-		Type:    "Profile",
-		ID:      "hjr265",
+	wantCodeforcesBlogEntry123456 = &URL{
+		Service: Codeforces,
+		Type:    "BlogEntry",
+		ID:      "123456",
 		Data: map[string]string{
-			"username": "hjr265",
+			"entryID": "123456",
 		},
 	}
-	wantThreadsHjr265 = &URL{
-		Service: Threads,
+	wantAtCoderTourist = &URL{
+		Service: AtCoder,
 		Type:    "Profile",
-		ID:      "hjr265",
+		ID:      "tourist",
 		Data: map[string]string{
-			"username": "hjr265",
+			"username": "tourist",
 		},
 	}
-	wantBlueskyHjr265 = &URL{
-		Service: Bluesky,
-		Type:    "Profile",
-		ID:      "hjr265.bsky.social",
+	wantAtCoderContestABC300 = &URL{
+		Service: AtCoder,
+		Type:    "Contest",
+		ID:      "abc300",
 		Data: map[string]string{
-			"handle": "hjr265.bsky.social",
+			"contestID": "abc300",
 		},
 	}
-	wantWhatsApp1234567890 = &URL{
-		Service: WhatsApp,
-		Type:    "Account",
-		ID:      "1234567890",
+	wantAtCoderTaskABC300A = &URL{
+		Service: AtCoder,
+		Type:    "Task",
+		ID:      "abc300_a",
 		Data: map[string]string{
-			"phoneNumber": "1234567890",
+			"contestID": "abc300",
+			"taskID":    "abc300_a",
 		},
 	}
-	wantWhatsAppPlus1234567890 = &URL{
-		Service: WhatsApp,
-		Type:    "Account",
-		ID:      "+1234567890",
+	wantHackerRankHjr265 = &URL{
+		Service: HackerRank,
+		Type:    "Profile",
+		ID:      "hjr265",
 		Data: map[string]string{
-			"phoneNumber": "+1234567890",
+			"username": "hjr265",
 		},
 	}
-	wantTwitchRayed152 = &URL{
-		Service: Twitch,
-		Type:    "Channel",
-		ID:      "rayed152",
+	wantHackerRankChallengeSolveMeFirst = &URL{
+		Service: HackerRank,
+		Type:    "Challenge",
+		ID:      "solve-me-first",
 		Data: map[string]string{
-			"username": "rayed152",
+			"challenge": "solve-me-first",
+		},
+	}
+	wantHackerRankContestProjectEuler = &URL{
+		Service: HackerRank,
+		Type:    "Contest",
+		ID:      "projecteuler",
+		Data: map[string]string{
+			"contest": "projecteuler",
 		},
 	}
 )
@@ -927,36 +3401,518 @@ func wantWithURL(want *URL, url *url.URL) *URL {
 
 func TestHostPatterns(t *testing.T) {
 	for _, c := range []struct {
-		host         string
-		maxWildcards int
-		want         []string
+		host string
+		want []string
 	}{
 		{
-			host:         "hjr265.github.io",
-			maxWildcards: 1,
-			want:         []string{"hjr265.github.io", "*.github.io"},
+			host: "hjr265.github.io",
+			want: []string{"hjr265.github.io", "*.github.io"},
+		},
+		{
+			host: "keyboard.cat.example.com",
+			want: []string{"keyboard.cat.example.com", "*.cat.example.com", "*.example.com"},
+		},
+		{
+			host: "github.com",
+			want: []string{"github.com"},
+		},
+		{
+			host: "pinterest.co.uk",
+			want: []string{"pinterest.co.uk"},
+		},
+		{
+			host: "www.pinterest.co.uk",
+			want: []string{"www.pinterest.co.uk", "*.pinterest.co.uk"},
 		},
 		{
-			host:         "keyboard.cat.example.com",
-			maxWildcards: 1,
-			want:         []string{"keyboard.cat.example.com", "*.cat.example.com"},
+			host: "shop.example.sch.uk",
+			want: []string{"shop.example.sch.uk"},
 		},
 		{
-			host:         "keyboard.cat.example.com",
-			maxWildcards: 2,
-			want:         []string{"keyboard.cat.example.com", "*.cat.example.com", "*.*.example.com"},
+			host: "127.0.0.1",
+			want: []string{"127.0.0.1"},
 		},
 	} {
-		got := hostPatterns(c.host, c.maxWildcards)
+		got := hostPatterns(c.host)
 		if !reflect.DeepEqual(got, c.want) {
 			t.Fatalf("want %v, got %v", c.want, got)
 		}
 	}
 }
 
+func TestRegistrableDomain(t *testing.T) {
+	for _, c := range []struct {
+		host   string
+		want   string
+		wantOK bool
+	}{
+		{host: "github.com", want: "github.com", wantOK: true},
+		{host: "hjr265.bandcamp.com", want: "bandcamp.com", wantOK: true},
+		{host: "www.pinterest.co.uk", want: "pinterest.co.uk", wantOK: true},
+		{host: "www.example.com.au", want: "example.com.au", wantOK: true},
+		{host: "foo.bar.ck", want: "foo.bar.ck", wantOK: true},
+		{host: "www.ck", want: "www.ck", wantOK: true},
+		{host: "www.example.ltd.uk", want: "example.ltd.uk", wantOK: true},
+		{host: "www.school.kent.sch.uk", want: "school.kent.sch.uk", wantOK: true},
+		{host: "www.city.kawasaki.jp", want: "city.kawasaki.jp", wantOK: true},
+		{host: "www.example.xn--55qx5d.cn", want: "example.xn--55qx5d.cn", wantOK: true},
+		{host: "foo.github.io", want: "github.io", wantOK: true},
+		{host: "co.uk"},
+		{host: "bar.ck"},
+		{host: "kent.sch.uk"},
+		{host: "xn--55qx5d.cn"},
+		{host: "com"},
+	} {
+		got, ok := registrableDomain(c.host)
+		if got != c.want || ok != c.wantOK {
+			t.Fatalf("%s: want %q, %t, got %q, %t", c.host, c.want, c.wantOK, got, ok)
+		}
+	}
+}
+
 func must(url *url.URL, err error) *url.URL {
 	if err != nil {
 		panic(err)
 	}
 	return url
 }
+
+func TestWhatsAppChatURL(t *testing.T) {
+	for _, c := range []struct {
+		phoneNumber string
+		text        string
+		want        string
+		wantErr     error
+	}{
+		{
+			phoneNumber: "1234567890",
+			want:        "https://wa.me/1234567890",
+		},
+		{
+			phoneNumber: "+1234567890",
+			text:        "Hello there! 1+1=2 & more",
+			want:        "https://wa.me/1234567890?text=Hello%20there%21%201%2B1%3D2%20%26%20more",
+		},
+		{
+			phoneNumber: "123",
+			wantErr:     ErrInvalidURL,
+		},
+	} {
+		got, err := WhatsAppChatURL(c.phoneNumber, c.text)
+		if c.wantErr != nil {
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("want error %q, got %q", c.wantErr, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Fatalf("want %q, got %q", c.want, got)
+		}
+
+		u, err := Parse(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Data["text"] != c.text {
+			t.Fatalf("want text %q, got %q", c.text, u.Data["text"])
+		}
+	}
+}
+
+func TestParsePhoneNumber(t *testing.T) {
+	for _, c := range []struct {
+		in     string
+		want   phoneNumber
+		wantOK bool
+	}{
+		{
+			in:     "+8801712345678",
+			want:   phoneNumber{countryCode: "880", nationalNumber: "1712345678"},
+			wantOK: true,
+		},
+		{
+			in:     "441632960961",
+			want:   phoneNumber{countryCode: "44", nationalNumber: "1632960961"},
+			wantOK: true,
+		},
+		{
+			in:     "+1 (415) 555-0100",
+			want:   phoneNumber{countryCode: "1", nationalNumber: "4155550100"},
+			wantOK: true,
+		},
+		{
+			in: "+2801234567",
+		},
+		{
+			in: "+123456",
+		},
+		{
+			in: "+1234567890123456",
+		},
+		{
+			in: "+1415555O100",
+		},
+	} {
+		got, ok := parsePhoneNumber(c.in)
+		if ok != c.wantOK {
+			t.Fatalf("%s: want ok %t, got %t", c.in, c.wantOK, ok)
+		}
+		if got != c.want {
+			t.Fatalf("%s: want %+v, got %+v", c.in, c.want, got)
+		}
+	}
+}
+
+func TestParsePhoneNumberAcrossServices(t *testing.T) {
+	var ids []string
+	for _, in := range []string{
+		"https://wa.me/8801712345678",
+		"https://t.me/+8801712345678",
+		"https://signal.me/#p/+8801712345678",
+	} {
+		u, err := Parse(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, u.ID)
+	}
+	for _, id := range ids {
+		if id != "+8801712345678" {
+			t.Fatalf("want %q, got %q", "+8801712345678", id)
+		}
+	}
+}
+
+func TestBlueskyURLConversion(t *testing.T) {
+	for _, c := range []struct {
+		appURL string
+		atURI  string
+	}{
+		{
+			appURL: "https://bsky.app/profile/hjr265.bsky.social",
+			atURI:  "at://hjr265.bsky.social",
+		},
+		{
+			appURL: "https://bsky.app/profile/hjr265.bsky.social/post/3k2a4xyzabc2y",
+			atURI:  "at://hjr265.bsky.social/app.bsky.feed.post/3k2a4xyzabc2y",
+		},
+		{
+			appURL: "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/feed/whats-hot",
+			atURI:  "at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.feed.generator/whats-hot",
+		},
+		{
+			appURL: "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur/lists/3k2a4xyzabc2y",
+			atURI:  "at://did:plc:z72i7hdynmk6r22z27h6tvur/app.bsky.graph.list/3k2a4xyzabc2y",
+		},
+	} {
+		u, err := Parse(c.appURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := BlueskyATURI(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.atURI {
+			t.Fatalf("want %q, got %q", c.atURI, got)
+		}
+
+		u, err = Parse(c.atURI)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err = BlueskyAppURL(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.appURL {
+			t.Fatalf("want %q, got %q", c.appURL, got)
+		}
+	}
+
+	u, err := Parse("https://github.com/hjr265")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BlueskyATURI(u); !errors.Is(err, ErrInvalidURL) {
+		t.Fatalf("want error %q, got %q", ErrInvalidURL, err)
+	}
+}
+
+func TestEncodePunycode(t *testing.T) {
+	for _, c := range []struct {
+		in   string
+		want string
+	}{
+		{in: "bücher", want: "bcher-kva"},
+		{in: "münchen", want: "mnchen-3ya"},
+		{in: "☃", want: "n3h"},
+		{in: "ليهمابتكلموشعربي؟", want: "egbpdaj6bu4bxfgehfvwxn"},
+		{in: "他们为什么不说中文", want: "ihqwcrb4cv8a8dqg056pqjye"},
+	} {
+		got, ok := encodePunycode(c.in)
+		if !ok {
+			t.Fatalf("%s: want ok", c.in)
+		}
+		if got != c.want {
+			t.Fatalf("%s: want %q, got %q", c.in, c.want, got)
+		}
+	}
+}
+
+type fakeBlueskyHandleResolver struct {
+	txt       map[string][]string
+	wellKnown map[string]string
+}
+
+func (r fakeBlueskyHandleResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, ok := r.txt[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+func (r fakeBlueskyHandleResolver) LookupWellKnownDID(ctx context.Context, handle string) (string, error) {
+	did, ok := r.wellKnown[handle]
+	if !ok {
+		return "", errors.New("404 Not Found")
+	}
+	return did, nil
+}
+
+func TestVerifyBlueskyHandle(t *testing.T) {
+	r := fakeBlueskyHandleResolver{
+		txt: map[string][]string{
+			"_atproto.dns.example.com":      {"v=spf1 -all", "did=did:plc:z72i7hdynmk6r22z27h6tvur"},
+			"_atproto.conflict.example.com": {"did=did:plc:z72i7hdynmk6r22z27h6tvur", "did=did:plc:aaaaaaaaaaaaaaaaaaaaaaaa"},
+			"_atproto.invalid.example.com":  {"did=did:plc:tooshort"},
+		},
+		wellKnown: map[string]string{
+			"web.example.com":     "did:plc:z72i7hdynmk6r22z27h6tvur\n",
+			"invalid.example.com": "<html></html>",
+		},
+	}
+	for _, c := range []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{
+			in:   "https://bsky.app/profile/dns.example.com",
+			want: "did:plc:z72i7hdynmk6r22z27h6tvur",
+		},
+		{
+			in:   "https://bsky.app/profile/web.example.com",
+			want: "did:plc:z72i7hdynmk6r22z27h6tvur",
+		},
+		{
+			in:      "https://bsky.app/profile/conflict.example.com",
+			wantErr: ErrUnverifiedHandle,
+		},
+		{
+			in:      "https://bsky.app/profile/invalid.example.com",
+			wantErr: ErrUnverifiedHandle,
+		},
+		{
+			in:      "https://bsky.app/profile/missing.example.com",
+			wantErr: ErrUnverifiedHandle,
+		},
+		{
+			in:      "https://bsky.app/profile/did:plc:z72i7hdynmk6r22z27h6tvur",
+			wantErr: ErrInvalidURL,
+		},
+	} {
+		u, err := Parse(c.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := VerifyBlueskyHandle(context.Background(), r, u)
+		if c.wantErr != nil {
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("%s: want error %q, got %q", c.in, c.wantErr, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Fatalf("%s: want %q, got %q", c.in, c.want, got)
+		}
+	}
+}
+
+func TestMetaSiblingURL(t *testing.T) {
+	for _, c := range []struct {
+		in      string
+		want    *URL
+		wantErr error
+	}{
+		{
+			in:   "https://www.threads.net/@rayed152",
+			want: wantWithURL(wantInstagramRayed152, must(url.Parse("https://www.instagram.com/rayed152/"))),
+		},
+		{
+			in:   "https://www.instagram.com/hjr265/",
+			want: wantWithURL(wantThreadsHjr265, must(url.Parse("https://www.threads.com/@hjr265"))),
+		},
+		{
+			in:      "https://threads.com/@hjr265/post/C8aBcD_eF-g",
+			wantErr: ErrUnsupported,
+		},
+		{
+			in:      "https://github.com/hjr265",
+			wantErr: ErrUnsupported,
+		},
+	} {
+		u, err := Parse(c.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := MetaSiblingURL(u)
+		if c.wantErr != nil {
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("%s: want error %q, got %q", c.in, c.wantErr, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(c.want, got) {
+			t.Fatal(cmp.Diff(c.want, got))
+		}
+	}
+}
+
+func TestRegisterSubstackDomain(t *testing.T) {
+	if err := RegisterSubstackDomain("Newsletter.Example.com", "hjr265"); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterSubstackDomain("bücher.example", "buecher"); err != nil {
+		t.Fatal(err)
+	}
+	for _, domain := range []string{"example..com", "github.com", "foo.github.io", "x.bandcamp.com", "bandcamp.com"} {
+		if err := RegisterSubstackDomain(domain, "hjr265"); !errors.Is(err, ErrInvalidURL) {
+			t.Fatalf("%s: want %v, got %v", domain, ErrInvalidURL, err)
+		}
+	}
+	if err := RegisterSubstackDomain("example.org", "www"); !errors.Is(err, ErrInvalidURL) {
+		t.Fatalf("www: want %v, got %v", ErrInvalidURL, err)
+	}
+	if err := RegisterSubstackDomain("newsletter.example.com", "hjr265"); err != nil {
+		t.Fatal(err)
+	}
+	for _, domain := range []string{"newsletter.example.com", "www.newsletter.example.com"} {
+		if err := RegisterSubstackDomain(domain, "someoneelse"); !errors.Is(err, ErrInvalidURL) {
+			t.Fatalf("%s: want %v, got %v", domain, ErrInvalidURL, err)
+		}
+	}
+
+	for _, c := range []struct {
+		in      string
+		want    *URL
+		wantErr error
+	}{
+		{
+			in: "https://newsletter.example.com",
+			want: &URL{
+				Service: Substack,
+				Type:    "Publication",
+				ID:      "hjr265",
+				Data: map[string]string{
+					"username": "hjr265",
+					"domain":   "newsletter.example.com",
+				},
+				URL: must(url.Parse("https://newsletter.example.com")),
+			},
+		},
+		{
+			in: "https://www.newsletter.example.com/p/writing-a-url-parser",
+			want: &URL{
+				Service: Substack,
+				Type:    "Post",
+				ID:      "hjr265/writing-a-url-parser",
+				Data: map[string]string{
+					"username": "hjr265",
+					"slug":     "writing-a-url-parser",
+					"domain":   "newsletter.example.com",
+				},
+				URL: must(url.Parse("https://www.newsletter.example.com/p/writing-a-url-parser")),
+			},
+		},
+		{
+			in: "https://BÜCHER.example./",
+			want: &URL{
+				Service: Substack,
+				Type:    "Publication",
+				ID:      "buecher",
+				Data: map[string]string{
+					"username": "buecher",
+					"domain":   "xn--bcher-kva.example",
+				},
+				URL: must(url.Parse("https://xn--bcher-kva.example/")),
+			},
+		},
+		{
+			in:      "https://newsletter.example.com/about",
+			wantErr: ErrInvalidURL,
+		},
+		{
+			in:      "https://example.com",
+			wantErr: ErrUnknownService,
+		},
+	} {
+		got, err := Parse(c.in)
+		if c.wantErr != nil {
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("%s: want error %q, got %q", c.in, c.wantErr, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(c.want, got) {
+			t.Fatal(cmp.Diff(c.want, got))
+		}
+	}
+}
+
+func TestDiscordSnowflakeTime(t *testing.T) {
+	for _, c := range []struct {
+		id      string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			id:   "175928689082269696",
+			want: time.Date(2016, time.April, 30, 11, 17, 48, 74000000, time.UTC),
+		},
+		{
+			id:   "1153012345678901248",
+			want: time.UnixMilli(1153012345678901248>>22 + 1420070400000).UTC(),
+		},
+		{
+			id:      "1759286890",
+			wantErr: true,
+		},
+		{
+			id:      "99999999999999999999",
+			wantErr: true,
+		},
+	} {
+		got, err := DiscordSnowflakeTime(c.id)
+		if c.wantErr {
+			if !errors.Is(err, ErrInvalidURL) {
+				t.Fatalf("%s: want %v, got %v", c.id, ErrInvalidURL, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(c.want) {
+			t.Fatalf("%s: want %s, got %s", c.id, c.want, got)
+		}
+	}
+}
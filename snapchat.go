@@ -6,7 +6,11 @@ import (
 	"strings"
 )
 
-// Snapchat Profile: ^https://(www\.)?snapchat\.com/add/[A-Za-z0-9._-]{3,15}/?$
+// Snapchat Profile: ^https://(www\.)?snapchat\.com/(add/|@)[A-Za-z][A-Za-z0-9._-]{1,13}[A-Za-z0-9]/?$
+// Snapchat Story: ^https://story\.snapchat\.com/@[A-Za-z][A-Za-z0-9._-]{1,13}[A-Za-z0-9]/?$
+// Snapchat ShortLink: ^https://(www\.)?snapchat\.com/t/[A-Za-z0-9]{1,16}/?$
+// Snapchat Spotlight: ^https://(www\.)?snapchat\.com/spotlight/[A-Za-z0-9_-]{1,200}/?$
+// Snapchat Lens: ^https://(www\.)?snapchat\.com/lens/[A-Za-z0-9_-]{1,200}/?$
 
 func decodeSnapchatURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,26 +20,71 @@ func decodeSnapchatURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Snapchat scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "snapchat.com" && url.Host != "www.snapchat.com" {
+	if url.Host != "snapchat.com" && url.Host != "www.snapchat.com" && url.Host != "story.snapchat.com" {
 		return nil, fmt.Errorf("%w: invalid Snapchat host", ErrInvalidURL)
 	}
 
 	path := strings.TrimSuffix(url.Path, "/")
-	if !strings.HasPrefix(path, "/add/") {
+	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Snapchat path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/add/")
+	if url.Host == "story.snapchat.com" {
+		if len(parts) != 1 || !strings.HasPrefix(parts[0], "@") {
+			return nil, fmt.Errorf("%w: invalid Snapchat path", ErrInvalidURL)
+		}
+		return newSnapchatUserURL(url, "Story", strings.TrimPrefix(parts[0], "@"))
+	}
+
+	switch {
+	case len(parts) == 1 && strings.HasPrefix(parts[0], "@"):
+		return newSnapchatUserURL(url, "Profile", strings.TrimPrefix(parts[0], "@"))
+
+	case len(parts) == 2 && parts[0] == "add":
+		return newSnapchatUserURL(url, "Profile", parts[1])
+
+	case len(parts) == 2 && parts[0] == "t":
+		code := parts[1]
+		if len(code) < 1 || len(code) > 16 {
+			return nil, fmt.Errorf("%w: invalid Snapchat short link code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotSnapchatCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Snapchat short link code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Snapchat,
+			Type:    "ShortLink",
+			ID:      code,
+			Data: map[string]string{
+				"code": code,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "spotlight":
+		return newSnapchatContentURL(url, "Spotlight", "spotlightID", parts[1])
+
+	case len(parts) == 2 && parts[0] == "lens":
+		return newSnapchatContentURL(url, "Lens", "lensID", parts[1])
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Snapchat path", ErrInvalidURL)
+	}
+}
+
+func newSnapchatUserURL(url *url.URL, typ, username string) (*URL, error) {
 	if len(username) < 3 || len(username) > 15 {
 		return nil, fmt.Errorf("%w: invalid Snapchat username length", ErrInvalidURL)
 	}
-	if strings.ContainsFunc(username, isNotSnapchatHandleRune) {
+	if !isSnapchatUsernameValid(username) {
 		return nil, fmt.Errorf("%w: invalid Snapchat username", ErrInvalidURL)
 	}
 
 	return &URL{
 		Service: Snapchat,
-		Type:    "Profile",
+		Type:    typ,
 		ID:      username,
 		Data: map[string]string{
 			"username": username,
@@ -44,8 +93,55 @@ func decodeSnapchatURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+func newSnapchatContentURL(url *url.URL, typ, key, id string) (*URL, error) {
+	if len(id) < 1 || len(id) > 200 {
+		return nil, fmt.Errorf("%w: invalid Snapchat %s ID length", ErrInvalidURL, strings.ToLower(typ))
+	}
+	if strings.ContainsFunc(id, isNotSnapchatIDRune) {
+		return nil, fmt.Errorf("%w: invalid Snapchat %s ID", ErrInvalidURL, strings.ToLower(typ))
+	}
+
+	return &URL{
+		Service: Snapchat,
+		Type:    typ,
+		ID:      id,
+		Data: map[string]string{
+			key: id,
+		},
+		URL: url,
+	}, nil
+}
+
+// isSnapchatUsernameValid reports whether username is made of letters, digits
+// and the separators ".", "_" and "-", starts with a letter and ends with a
+// letter or digit.
+func isSnapchatUsernameValid(username string) bool {
+	if strings.ContainsFunc(username, isNotSnapchatHandleRune) {
+		return false
+	}
+	first, last := rune(username[0]), rune(username[len(username)-1])
+	return strings.ContainsRune(snapchatLetterAlpha, first) && !strings.ContainsRune(snapchatSeparatorAlpha, last)
+}
+
 const snapchatHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._-"
 
 func isNotSnapchatHandleRune(r rune) bool {
 	return !strings.ContainsRune(snapchatHandleAlpha, r)
 }
+
+const (
+	snapchatLetterAlpha    = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	snapchatSeparatorAlpha = "._-"
+)
+
+const snapchatCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotSnapchatCodeRune(r rune) bool {
+	return !strings.ContainsRune(snapchatCodeAlpha, r)
+}
+
+const snapchatIDAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotSnapchatIDRune(r rune) bool {
+	return !strings.ContainsRune(snapchatIDAlpha, r)
+}
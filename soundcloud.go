@@ -6,7 +6,12 @@ import (
 	"strings"
 )
 
-// SoundCloud Profile: ^https://(www\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/?$
+// SoundCloud Profile: ^https://(www\.|m\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/?$
+// SoundCloud Track: ^https://(www\.|m\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/[A-Za-z0-9_-]{1,255}/?$
+// SoundCloud Playlist: ^https://(www\.|m\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/sets/[A-Za-z0-9_-]{1,255}/?$
+// SoundCloud Likes: ^https://(www\.|m\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/likes/?$
+// SoundCloud Reposts: ^https://(www\.|m\.)?soundcloud\.com/[A-Za-z0-9_-]{3,25}/reposts/?$
+// SoundCloud ShortLink: ^https://on\.soundcloud\.com/[A-Za-z0-9]{1,32}/?$
 
 func decodeSoundCloudURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,7 +21,7 @@ func decodeSoundCloudURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid SoundCloud scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "soundcloud.com" && url.Host != "www.soundcloud.com" {
+	if url.Host != "soundcloud.com" && url.Host != "www.soundcloud.com" && url.Host != "m.soundcloud.com" && url.Host != "on.soundcloud.com" {
 		return nil, fmt.Errorf("%w: invalid SoundCloud host", ErrInvalidURL)
 	}
 
@@ -24,8 +29,36 @@ func decodeSoundCloudURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid SoundCloud path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
+	if url.Host == "on.soundcloud.com" {
+		if len(parts) != 1 {
+			return nil, fmt.Errorf("%w: invalid SoundCloud path", ErrInvalidURL)
+		}
+
+		code := parts[0]
+		if len(code) < 1 || len(code) > 32 {
+			return nil, fmt.Errorf("%w: invalid SoundCloud short link code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotSoundCloudCodeRune) {
+			return nil, fmt.Errorf("%w: invalid SoundCloud short link code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: SoundCloud,
+			Type:    "ShortLink",
+			ID:      code,
+			Data: map[string]string{
+				"code": code,
+			},
+			URL: url,
+		}, nil
+	}
+
+	username := parts[0]
+	if soundcloudReservedPaths[username] {
+		return nil, fmt.Errorf("%w: invalid SoundCloud path", ErrInvalidURL)
+	}
 	if len(username) < 3 || len(username) > 25 {
 		return nil, fmt.Errorf("%w: invalid SoundCloud username length", ErrInvalidURL)
 	}
@@ -33,15 +66,110 @@ func decodeSoundCloudURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid SoundCloud username", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: SoundCloud,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch {
+	case len(parts) == 1:
+		return &URL{
+			Service: SoundCloud,
+			Type:    "Profile",
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && (parts[1] == "likes" || parts[1] == "reposts"):
+		return &URL{
+			Service: SoundCloud,
+			Type:    strings.ToUpper(parts[1][:1]) + parts[1][1:],
+			ID:      username,
+			Data: map[string]string{
+				"username": username,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 3 && parts[1] == "sets":
+		playlist := parts[2]
+		if !isSoundCloudSlugValid(playlist) {
+			return nil, fmt.Errorf("%w: invalid SoundCloud playlist", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: SoundCloud,
+			Type:    "Playlist",
+			ID:      username + "/" + playlist,
+			Data: map[string]string{
+				"username": username,
+				"playlist": playlist,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && !soundcloudReservedUserPaths[parts[1]]:
+		track := parts[1]
+		if !isSoundCloudSlugValid(track) {
+			return nil, fmt.Errorf("%w: invalid SoundCloud track", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: SoundCloud,
+			Type:    "Track",
+			ID:      username + "/" + track,
+			Data: map[string]string{
+				"username": username,
+				"track":    track,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid SoundCloud path", ErrInvalidURL)
+	}
+}
+
+func isSoundCloudSlugValid(slug string) bool {
+	return len(slug) >= 1 && len(slug) <= 255 && !strings.ContainsFunc(slug, isNotSoundCloudHandleRune)
+}
+
+// soundcloudReservedPaths holds the first segments of SoundCloud's own pages,
+// like /discover and /stream, which would otherwise be read as usernames.
+var soundcloudReservedPaths = map[string]bool{
+	"charts":        true,
+	"discover":      true,
+	"feed":          true,
+	"jobs":          true,
+	"logout":        true,
+	"messages":      true,
+	"mobile":        true,
+	"notifications": true,
+	"pages":         true,
+	"people":        true,
+	"pro":           true,
+	"search":        true,
+	"settings":      true,
+	"signin":        true,
+	"stations":      true,
+	"stream":        true,
+	"tags":          true,
+	"terms-of-use":  true,
+	"upload":        true,
+	"you":           true,
+}
+
+// soundcloudReservedUserPaths holds the profile tabs, like /{user}/tracks,
+// /{user}/likes and /{user}/sets. Tracks are at /{user}/{slug} too, so a path
+// naming one of these tabs must not be taken for a track.
+var soundcloudReservedUserPaths = map[string]bool{
+	"albums":         true,
+	"comments":       true,
+	"followers":      true,
+	"following":      true,
+	"likes":          true,
+	"popular-tracks": true,
+	"reposts":        true,
+	"sets":           true,
+	"tracks":         true,
 }
 
 const soundcloudHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
@@ -49,3 +177,9 @@ const soundcloudHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvw
 func isNotSoundCloudHandleRune(r rune) bool {
 	return !strings.ContainsRune(soundcloudHandleAlpha, r)
 }
+
+const soundcloudCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+func isNotSoundCloudCodeRune(r rune) bool {
+	return !strings.ContainsRune(soundcloudCodeAlpha, r)
+}
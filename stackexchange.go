@@ -0,0 +1,181 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Stack Exchange User: ^https://{site}/(users|u)/[0-9]{1,20}(/[a-z0-9-]{1,200})?/?$
+// Stack Exchange Question: ^https://{site}/questions/[0-9]{1,20}(/[a-z0-9-]{1,200})?/?$
+// Stack Exchange Question: ^https://{site}/q/[0-9]{1,20}(/[0-9]{1,20})?/?$
+// Stack Exchange Answer: ^https://{site}/questions/[0-9]{1,20}/[a-z0-9-]{1,200}/[0-9]{1,20}/?$
+// Stack Exchange Answer: ^https://{site}/a/[0-9]{1,20}(/[0-9]{1,20})?/?$
+//
+// Where {site} is a site of the Stack Exchange network, like stackoverflow.com,
+// superuser.com or math.stackexchange.com, or its meta site. User, question and
+// answer IDs are only unique within a site, so IDs are prefixed with the site.
+// User profiles on stackexchange.com itself are network-wide accounts. The
+// numbers after short links are the IDs of the users who shared them.
+
+func decodeStackExchangeURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid Stack Exchange scheme", ErrInvalidURL)
+	}
+
+	site := strings.TrimPrefix(url.Host, "www.")
+	if !isStackExchangeSite(site) {
+		return nil, fmt.Errorf("%w: invalid Stack Exchange host", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Stack Exchange path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case (len(parts) == 2 || len(parts) == 3) && parts[0] == "users":
+		return newStackExchangeURL(url, site, "User", "userID", parts[1], parts[2:])
+
+	case len(parts) == 2 && parts[0] == "u":
+		return newStackExchangeURL(url, site, "User", "userID", parts[1], nil)
+
+	case (len(parts) == 2 || len(parts) == 3) && parts[0] == "questions":
+		return newStackExchangeURL(url, site, "Question", "questionID", parts[1], parts[2:])
+
+	case len(parts) == 4 && parts[0] == "questions":
+		u, err := newStackExchangeURL(url, site, "Answer", "answerID", parts[3], nil)
+		if err != nil {
+			return nil, err
+		}
+		if !isStackExchangeIDValid(parts[1]) {
+			return nil, fmt.Errorf("%w: invalid Stack Exchange question ID", ErrInvalidURL)
+		}
+		if !isStackExchangeSlugValid(parts[2]) {
+			return nil, fmt.Errorf("%w: invalid Stack Exchange slug", ErrInvalidURL)
+		}
+		u.Data["questionID"] = parts[1]
+		u.Data["slug"] = parts[2]
+		return u, nil
+
+	case (len(parts) == 2 || len(parts) == 3) && (parts[0] == "q" || parts[0] == "a"):
+		typ, key := "Question", "questionID"
+		if parts[0] == "a" {
+			typ, key = "Answer", "answerID"
+		}
+		u, err := newStackExchangeURL(url, site, typ, key, parts[1], nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) == 3 {
+			if !isStackExchangeIDValid(parts[2]) {
+				return nil, fmt.Errorf("%w: invalid Stack Exchange user ID", ErrInvalidURL)
+			}
+			u.Data["sharerID"] = parts[2]
+		}
+		return u, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Stack Exchange path", ErrInvalidURL)
+	}
+}
+
+// newStackExchangeURL returns the typ with the numeric id on site, keyed by
+// key in Data, along with the slug in rest, if any.
+func newStackExchangeURL(url *url.URL, site, typ, key, id string, rest []string) (*URL, error) {
+	if !isStackExchangeIDValid(id) {
+		return nil, fmt.Errorf("%w: invalid Stack Exchange ID", ErrInvalidURL)
+	}
+
+	data := map[string]string{
+		"site": site,
+		key:    id,
+	}
+	if len(rest) > 0 {
+		if !isStackExchangeSlugValid(rest[0]) {
+			return nil, fmt.Errorf("%w: invalid Stack Exchange slug", ErrInvalidURL)
+		}
+		data["slug"] = rest[0]
+	}
+
+	return &URL{
+		Service: StackExchange,
+		Type:    typ,
+		ID:      site + "/" + id,
+		Data:    data,
+		URL:     url,
+	}, nil
+}
+
+// stackExchangeSites holds the Stack Exchange sites that have domains of their
+// own, outside stackexchange.com.
+var stackExchangeSites = map[string]bool{
+	"askubuntu.com":     true,
+	"mathoverflow.net":  true,
+	"serverfault.com":   true,
+	"stackapps.com":     true,
+	"stackexchange.com": true,
+	"stackoverflow.com": true,
+	"superuser.com":     true,
+}
+
+// stackOverflowLanguages holds the subdomains of stackoverflow.com that are
+// Stack Overflow sites in other languages.
+var stackOverflowLanguages = map[string]bool{
+	"es": true,
+	"ja": true,
+	"pt": true,
+	"ru": true,
+}
+
+// stackExchangeReservedSubdomains holds the subdomains of stackexchange.com
+// that run the network's services rather than Q&A sites, like area51 for
+// site proposals.
+var stackExchangeReservedSubdomains = map[string]bool{
+	"api":    true,
+	"area51": true,
+	"chat":   true,
+	"data":   true,
+	"openid": true,
+}
+
+// isStackExchangeSite reports whether host, without "www.", is a site of the
+// Stack Exchange network or the meta site of one.
+func isStackExchangeSite(host string) bool {
+	host = strings.TrimPrefix(host, "meta.")
+	if stackExchangeSites[host] {
+		return true
+	}
+	if lang, ok := strings.CutSuffix(host, ".stackoverflow.com"); ok {
+		return stackOverflowLanguages[lang]
+	}
+	if name, ok := strings.CutSuffix(host, ".stackexchange.com"); ok {
+		name = strings.TrimSuffix(name, ".meta")
+		return !strings.Contains(name, ".") && !stackExchangeReservedSubdomains[name]
+	}
+	return false
+}
+
+func isStackExchangeIDValid(id string) bool {
+	return len(id) >= 1 && len(id) <= 20 && !strings.ContainsFunc(id, isNotStackExchangeIDRune)
+}
+
+func isStackExchangeSlugValid(slug string) bool {
+	return len(slug) >= 1 && len(slug) <= 200 && !strings.ContainsFunc(slug, isNotStackExchangeSlugRune)
+}
+
+const stackExchangeIDAlpha = "0123456789"
+
+func isNotStackExchangeIDRune(r rune) bool {
+	return !strings.ContainsRune(stackExchangeIDAlpha, r)
+}
+
+const stackExchangeSlugAlpha = "abcdefghijklmnopqrstuvwxyz0123456789-"
+
+func isNotStackExchangeSlugRune(r rune) bool {
+	return !strings.ContainsRune(stackExchangeSlugAlpha, r)
+}
@@ -7,6 +7,14 @@ import (
 )
 
 // Substack Publication: ^https://[A-Za-z0-9-]+\.substack\.com/?$
+// Substack Post: ^https://[A-Za-z0-9-]+\.substack\.com/p/[A-Za-z0-9-]{1,200}/?$
+// Substack Post: ^https://open\.substack\.com/pub/[A-Za-z0-9-]+/p/[A-Za-z0-9-]{1,200}/?$
+// Substack Podcast: ^https://[A-Za-z0-9-]+\.substack\.com/podcast/?$
+// Substack Profile: ^https://(www\.)?substack\.com/@[A-Za-z0-9_]{1,30}/?$
+// Substack Note: ^https://(www\.)?substack\.com/@[A-Za-z0-9_]{1,30}/note/c-[0-9]{1,20}/?$
+//
+// Publications on custom domains are recognised once registered with
+// RegisterSubstackDomain.
 
 func decodeSubstackURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,27 +24,213 @@ func decodeSubstackURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Substack scheme", ErrInvalidURL)
 	}
 
-	if !strings.HasSuffix(url.Host, ".substack.com") {
+	parts, err := substackPathParts(url)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case url.Host == "substack.com" || url.Host == "www.substack.com":
+		if len(parts) < 1 || !strings.HasPrefix(parts[0], "@") {
+			return nil, fmt.Errorf("%w: invalid Substack path", ErrInvalidURL)
+		}
+
+		username := strings.TrimPrefix(parts[0], "@")
+		if len(username) < 1 || len(username) > 30 {
+			return nil, fmt.Errorf("%w: invalid Substack username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotSubstackUserHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Substack username", ErrInvalidURL)
+		}
+
+		switch {
+		case len(parts) == 1:
+			return &URL{
+				Service: Substack,
+				Type:    "Profile",
+				ID:      username,
+				Data: map[string]string{
+					"username": username,
+				},
+				URL: url,
+			}, nil
+
+		case len(parts) == 3 && parts[1] == "note":
+			noteID := parts[2]
+			if !strings.HasPrefix(noteID, "c-") || len(noteID) < 3 || len(noteID) > 22 || strings.ContainsFunc(noteID[2:], isNotSubstackIDRune) {
+				return nil, fmt.Errorf("%w: invalid Substack note ID", ErrInvalidURL)
+			}
+
+			return &URL{
+				Service: Substack,
+				Type:    "Note",
+				ID:      noteID,
+				Data: map[string]string{
+					"username": username,
+					"noteID":   noteID,
+				},
+				URL: url,
+			}, nil
+
+		default:
+			return nil, fmt.Errorf("%w: invalid Substack path", ErrInvalidURL)
+		}
+
+	case url.Host == "open.substack.com":
+		if len(parts) < 2 || parts[0] != "pub" {
+			return nil, fmt.Errorf("%w: invalid Substack path", ErrInvalidURL)
+		}
+		return newSubstackPublicationURL(url, parts[1], parts[2:])
+
+	case strings.HasSuffix(url.Host, ".substack.com"):
+		return newSubstackPublicationURL(url, strings.TrimSuffix(url.Host, ".substack.com"), parts)
+
+	default:
 		return nil, fmt.Errorf("%w: invalid Substack host", ErrInvalidURL)
 	}
+}
+
+// RegisterSubstackDomain registers domain, and its "www." subdomain, as the
+// custom domain of the Substack publication at {publication}.substack.com.
+// Parse recognises URLs on registered domains as Substack URLs, with the
+// custom domain in Data["domain"]. Registering a domain again for the same
+// publication has no effect, but a host already registered for another
+// publication is an error.
+func RegisterSubstackDomain(domain, publication string) error {
+	host, ok := toASCIIDomain(strings.TrimSuffix(domain, "."))
+	if !ok || !isDomainNameValid(host) {
+		return fmt.Errorf("%w: invalid Substack custom domain %q", ErrInvalidURL, domain)
+	}
+	domain = host
+	if !isSubstackPublicationValid(publication) {
+		return fmt.Errorf("%w: invalid Substack publication %q", ErrInvalidURL, publication)
+	}
+	// Custom hosts are looked up before the wildcard patterns of supported
+	// services, so a subdomain like "foo.github.io" would otherwise take
+	// over URLs that belong to them.
+	for _, host := range []string{domain, "www." + domain} {
+		for _, pattern := range hostPatterns(host) {
+			if _, ok := decodeURLFuncs[pattern]; ok {
+				return fmt.Errorf("%w: invalid Substack custom domain %q: host belongs to a supported service", ErrInvalidURL, domain)
+			}
+		}
+	}
 
-	username := strings.TrimSuffix(url.Host, ".substack.com")
-	if len(username) < 1 || len(username) > 30 {
-		return nil, fmt.Errorf("%w: invalid Substack username length", ErrInvalidURL)
+	decodeFunc := newSubstackDomainDecoder(domain, publication)
+	if !registerDecodeURLFunc([]string{domain, "www." + domain}, "substack:"+publication, decodeFunc) {
+		return fmt.Errorf("%w: invalid Substack custom domain %q: host is registered to another publication", ErrInvalidURL, domain)
 	}
-	if strings.ContainsFunc(username, isNotSubstackHandleRune) {
+	return nil
+}
+
+func newSubstackDomainDecoder(domain, publication string) decodeURLFunc {
+	return func(url *url.URL) (*URL, error) {
+		if url.Scheme == "http" {
+			url.Scheme = "https"
+		}
+		if url.Scheme != "https" {
+			return nil, fmt.Errorf("%w: invalid Substack scheme", ErrInvalidURL)
+		}
+
+		if url.Host != domain && url.Host != "www."+domain {
+			return nil, fmt.Errorf("%w: invalid Substack host", ErrInvalidURL)
+		}
+
+		parts, err := substackPathParts(url)
+		if err != nil {
+			return nil, err
+		}
+
+		u, err := newSubstackPublicationURL(url, publication, parts)
+		if err != nil {
+			return nil, err
+		}
+		u.Data["domain"] = domain
+		return u, nil
+	}
+}
+
+// newSubstackPublicationURL returns the publication with the given subdomain,
+// or the page under it that parts, the path segments, refer to.
+func newSubstackPublicationURL(url *url.URL, publication string, parts []string) (*URL, error) {
+	if !isSubstackPublicationValid(publication) {
 		return nil, fmt.Errorf("%w: invalid Substack username", ErrInvalidURL)
 	}
 
-	return &URL{
-		Service: Substack,
-		Type:    "Publication",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
-	}, nil
+	switch {
+	case len(parts) == 0:
+		return &URL{
+			Service: Substack,
+			Type:    "Publication",
+			ID:      publication,
+			Data: map[string]string{
+				"username": publication,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 1 && parts[0] == "podcast":
+		return &URL{
+			Service: Substack,
+			Type:    "Podcast",
+			ID:      publication,
+			Data: map[string]string{
+				"username": publication,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "p":
+		slug := parts[1]
+		if len(slug) < 1 || len(slug) > 200 {
+			return nil, fmt.Errorf("%w: invalid Substack post slug length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(slug, isNotSubstackHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Substack post slug", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Substack,
+			Type:    "Post",
+			ID:      publication + "/" + slug,
+			Data: map[string]string{
+				"username": publication,
+				"slug":     slug,
+			},
+			URL: url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Substack path", ErrInvalidURL)
+	}
+}
+
+func substackPathParts(url *url.URL) ([]string, error) {
+	path := strings.TrimSuffix(url.Path, "/")
+	if path == "" {
+		return nil, nil
+	}
+	if path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Substack path", ErrInvalidURL)
+	}
+	return strings.Split(strings.TrimPrefix(path, "/"), "/"), nil
+}
+
+func isSubstackPublicationValid(publication string) bool {
+	if len(publication) < 1 || len(publication) > 30 || substackReservedSubdomains[publication] {
+		return false
+	}
+	return !strings.ContainsFunc(publication, isNotSubstackHandleRune)
+}
+
+// substackReservedSubdomains holds the subdomains of substack.com that Substack
+// serves its app, API and help centre from, which no publication can have.
+var substackReservedSubdomains = map[string]bool{
+	"api":     true,
+	"cdn":     true,
+	"open":    true,
+	"support": true,
+	"www":     true,
 }
 
 const substackHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"
@@ -44,3 +238,15 @@ const substackHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxy
 func isNotSubstackHandleRune(r rune) bool {
 	return !strings.ContainsRune(substackHandleAlpha, r)
 }
+
+const substackUserHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+
+func isNotSubstackUserHandleRune(r rune) bool {
+	return !strings.ContainsRune(substackUserHandleAlpha, r)
+}
+
+const substackIDAlpha = "0123456789"
+
+func isNotSubstackIDRune(r rune) bool {
+	return !strings.ContainsRune(substackIDAlpha, r)
+}
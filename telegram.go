@@ -6,10 +6,23 @@ import (
 	"strings"
 )
 
-// Telegram Account: ^https://t\.me/[A-Za-z0-9_]{5,32}$
-// Telegram Account with Phone Number: ^https://t\.me/+[0-9]{1,15}$
+// Telegram Account: ^https://t\.me/(s/)?[A-Za-z0-9_]{5,32}$
+// Telegram Account with Phone Number: ^https://t\.me/\+[0-9]{7,15}$
+// Telegram Post: ^https://t\.me/(s/)?[A-Za-z0-9_]{5,32}/[0-9]{1,20}$
+// Telegram Invite: ^https://t\.me/\+[A-Za-z0-9_-]{1,64}$
+// Telegram Invite: ^https://t\.me/joinchat/[A-Za-z0-9_-]{1,64}$
+// Telegram Sticker Set: ^https://t\.me/addstickers/[A-Za-z0-9_]{1,64}$
+// Telegram Account: ^tg://resolve\?domain=[A-Za-z0-9_]{5,32}$
+// Telegram Account with Phone Number: ^tg://resolve\?phone=[0-9]{7,15}$
+// Telegram Post: ^tg://resolve\?domain=[A-Za-z0-9_]{5,32}&post=[0-9]{1,20}$
+// Telegram Invite: ^tg://join\?invite=[A-Za-z0-9_-]{1,64}$
+// Telegram Sticker Set: ^tg://addstickers\?set=[A-Za-z0-9_]{1,64}$
 
 func decodeTelegramURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "tg" {
+		return decodeTelegramDeepLink(url)
+	}
+
 	if url.Scheme == "http" {
 		url.Scheme = "https"
 	}
@@ -22,53 +35,160 @@ func decodeTelegramURL(url *url.URL) (*URL, error) {
 	}
 
 	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid Telegram path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
 	switch {
-	case strings.HasPrefix(path, "/+"):
-		if len(path) < 1 || path[0] != '/' {
-			return nil, fmt.Errorf("%w: invalid Telegram path", ErrInvalidURL)
+	case len(parts) == 1 && strings.HasPrefix(parts[0], "+"):
+		// Phone numbers and private invite hashes share the "+" prefix. Only
+		// the former are made up of digits alone.
+		hash := strings.TrimPrefix(parts[0], "+")
+		if hash != "" && !strings.ContainsFunc(hash, isNotTelegramIDRune) {
+			return newTelegramPhoneNumberURL(url, parts[0])
 		}
+		return newTelegramInviteURL(url, hash)
 
-		phoneNumber := strings.TrimPrefix(path, "/")
-		if len(phoneNumber) < 1 || len(phoneNumber) > 16 {
-			return nil, fmt.Errorf("%w: invalid Telegram phone number length", ErrInvalidURL)
-		}
-		if strings.ContainsFunc(phoneNumber, isNotTelegramPhoneNumberRune) {
-			return nil, fmt.Errorf("%w: invalid Telegram phone number", ErrInvalidURL)
-		}
+	case len(parts) == 2 && parts[0] == "joinchat":
+		return newTelegramInviteURL(url, parts[1])
 
-		return &URL{
-			Service: Telegram,
-			Type:    "Account",
-			ID:      phoneNumber,
-			Data: map[string]string{
-				"phoneNumber": phoneNumber,
-			},
-			URL: url,
-		}, nil
+	case len(parts) == 2 && parts[0] == "addstickers":
+		return newTelegramStickerSetURL(url, parts[1])
+
+	case len(parts) == 2 && parts[0] == "s":
+		return newTelegramAccountURL(url, parts[1])
+
+	case len(parts) == 3 && parts[0] == "s":
+		return newTelegramPostURL(url, parts[1], parts[2])
+
+	case len(parts) == 2:
+		return newTelegramPostURL(url, parts[0], parts[1])
+
+	case len(parts) == 1:
+		return newTelegramAccountURL(url, parts[0])
 
 	default:
-		if len(path) < 1 || path[0] != '/' {
-			return nil, fmt.Errorf("%w: invalid Telegram path", ErrInvalidURL)
-		}
+		return nil, fmt.Errorf("%w: invalid Telegram path", ErrInvalidURL)
+	}
+}
 
-		username := strings.TrimPrefix(path, "/")
-		if len(username) < 5 || len(username) > 32 {
-			return nil, fmt.Errorf("%w: invalid Telegram username length", ErrInvalidURL)
+func decodeTelegramDeepLink(url *url.URL) (*URL, error) {
+	query := url.Query()
+	switch url.Host {
+	case "resolve":
+		if phoneNumber := query.Get("phone"); phoneNumber != "" {
+			return newTelegramPhoneNumberURL(url, phoneNumber)
 		}
-		if strings.ContainsFunc(username, isNotTelegramHandleRune) {
-			return nil, fmt.Errorf("%w: invalid Telegram username", ErrInvalidURL)
+		if postID := query.Get("post"); postID != "" {
+			return newTelegramPostURL(url, query.Get("domain"), postID)
 		}
+		return newTelegramAccountURL(url, query.Get("domain"))
+
+	case "join":
+		return newTelegramInviteURL(url, query.Get("invite"))
+
+	case "addstickers":
+		return newTelegramStickerSetURL(url, query.Get("set"))
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Telegram path", ErrInvalidURL)
+	}
+}
+
+func newTelegramAccountURL(url *url.URL, username string) (*URL, error) {
+	if !isTelegramUsernameValid(username) {
+		return nil, fmt.Errorf("%w: invalid Telegram username", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Telegram,
+		Type:    "Account",
+		ID:      username,
+		Data: map[string]string{
+			"username": username,
+		},
+		URL: url,
+	}, nil
+}
+
+func newTelegramPhoneNumberURL(url *url.URL, s string) (*URL, error) {
+	phoneNumber, ok := parsePhoneNumber(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid Telegram phone number", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Telegram,
+		Type:    "Account",
+		ID:      phoneNumber.String(),
+		Data:    phoneNumber.data(),
+		URL:     url,
+	}, nil
+}
+
+func newTelegramPostURL(url *url.URL, username, postID string) (*URL, error) {
+	if !isTelegramUsernameValid(username) {
+		return nil, fmt.Errorf("%w: invalid Telegram username", ErrInvalidURL)
+	}
+	if len(postID) < 1 || len(postID) > 20 || strings.ContainsFunc(postID, isNotTelegramIDRune) {
+		return nil, fmt.Errorf("%w: invalid Telegram post ID", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Telegram,
+		Type:    "Post",
+		ID:      username + "/" + postID,
+		Data: map[string]string{
+			"username": username,
+			"postID":   postID,
+		},
+		URL: url,
+	}, nil
+}
+
+// newTelegramInviteURL returns the invite with inviteHash. Hashes are usually
+// 16 or 22 characters long, but shorter ones are in use too.
+func newTelegramInviteURL(url *url.URL, inviteHash string) (*URL, error) {
+	if len(inviteHash) < 1 || len(inviteHash) > 64 {
+		return nil, fmt.Errorf("%w: invalid Telegram invite hash length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(inviteHash, isNotTelegramInviteHashRune) {
+		return nil, fmt.Errorf("%w: invalid Telegram invite hash", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Telegram,
+		Type:    "Invite",
+		ID:      inviteHash,
+		Data: map[string]string{
+			"inviteHash": inviteHash,
+		},
+		URL: url,
+	}, nil
+}
 
-		return &URL{
-			Service: Telegram,
-			Type:    "Account",
-			ID:      username,
-			Data: map[string]string{
-				"username": username,
-			},
-			URL: url,
-		}, nil
+func newTelegramStickerSetURL(url *url.URL, stickerSet string) (*URL, error) {
+	if len(stickerSet) < 1 || len(stickerSet) > 64 {
+		return nil, fmt.Errorf("%w: invalid Telegram sticker set length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(stickerSet, isNotTelegramHandleRune) {
+		return nil, fmt.Errorf("%w: invalid Telegram sticker set", ErrInvalidURL)
 	}
+
+	return &URL{
+		Service: Telegram,
+		Type:    "StickerSet",
+		ID:      stickerSet,
+		Data: map[string]string{
+			"stickerSet": stickerSet,
+		},
+		URL: url,
+	}, nil
+}
+
+func isTelegramUsernameValid(username string) bool {
+	return len(username) >= 5 && len(username) <= 32 && !strings.ContainsFunc(username, isNotTelegramHandleRune)
 }
 
 const telegramHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
@@ -77,8 +197,14 @@ func isNotTelegramHandleRune(r rune) bool {
 	return !strings.ContainsRune(telegramHandleAlpha, r)
 }
 
-const telegramPhoneNumberAlpha = "0123456789+"
+const telegramIDAlpha = "0123456789"
+
+func isNotTelegramIDRune(r rune) bool {
+	return !strings.ContainsRune(telegramIDAlpha, r)
+}
+
+const telegramInviteHashAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
 
-func isNotTelegramPhoneNumberRune(r rune) bool {
-	return !strings.ContainsRune(telegramPhoneNumberAlpha, r)
+func isNotTelegramInviteHashRune(r rune) bool {
+	return !strings.ContainsRune(telegramInviteHashAlpha, r)
 }
@@ -6,7 +6,8 @@ import (
 	"strings"
 )
 
-// Threads Profile: ^https://(www\.)?threads\.net/@[A-Za-z0-9._]{1,30}/?$
+// Threads Profile: ^https://(www\.)?threads\.(com|net)/@[A-Za-z0-9._]{1,30}/?$
+// Threads Post: ^https://(www\.)?threads\.(com|net)/@[A-Za-z0-9._]{1,30}/post/[A-Za-z0-9_-]{1,64}/?$
 
 func decodeThreadsURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,7 +17,7 @@ func decodeThreadsURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Threads scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "threads.net" && url.Host != "www.threads.net" {
+	if url.Host != "threads.com" && url.Host != "www.threads.com" && url.Host != "threads.net" && url.Host != "www.threads.net" {
 		return nil, fmt.Errorf("%w: invalid Threads host", ErrInvalidURL)
 	}
 
@@ -24,8 +25,12 @@ func decodeThreadsURL(url *url.URL) (*URL, error) {
 	if !strings.HasPrefix(path, "/@") {
 		return nil, fmt.Errorf("%w: invalid Threads path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/@"), "/")
+	if len(parts) != 1 && (len(parts) != 3 || parts[1] != "post") {
+		return nil, fmt.Errorf("%w: invalid Threads path", ErrInvalidURL)
+	}
 
-	username := strings.TrimPrefix(path, "/@")
+	username := parts[0]
 	if len(username) < 1 || len(username) > 30 {
 		return nil, fmt.Errorf("%w: invalid Threads username length", ErrInvalidURL)
 	}
@@ -33,6 +38,27 @@ func decodeThreadsURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Threads username", ErrInvalidURL)
 	}
 
+	if len(parts) == 3 {
+		postCode := parts[2]
+		if len(postCode) < 1 || len(postCode) > 64 {
+			return nil, fmt.Errorf("%w: invalid Threads post code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(postCode, isNotThreadsPostCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Threads post code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Threads,
+			Type:    "Post",
+			ID:      postCode,
+			Data: map[string]string{
+				"username": username,
+				"postCode": postCode,
+			},
+			URL: url,
+		}, nil
+	}
+
 	return &URL{
 		Service: Threads,
 		Type:    "Profile",
@@ -44,8 +70,32 @@ func decodeThreadsURL(url *url.URL) (*URL, error) {
 	}, nil
 }
 
+// MetaSiblingURL returns the profile on the sibling Meta service for u, a
+// parsed Threads or Instagram profile URL. Threads accounts are created from
+// Instagram accounts and share their usernames.
+func MetaSiblingURL(u *URL) (*URL, error) {
+	if u.Type != "Profile" {
+		return nil, fmt.Errorf("%w: %s %s has no sibling", ErrUnsupported, u.Service, u.Type)
+	}
+
+	switch u.Service {
+	case Threads:
+		return Parse("https://www.instagram.com/" + u.Data["username"] + "/")
+	case Instagram:
+		return Parse("https://www.threads.com/@" + u.Data["username"])
+	default:
+		return nil, fmt.Errorf("%w: %s has no sibling", ErrUnsupported, u.Service)
+	}
+}
+
 const threadsHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._"
 
 func isNotThreadsHandleRune(r rune) bool {
 	return !strings.ContainsRune(threadsHandleAlpha, r)
 }
+
+const threadsPostCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotThreadsPostCodeRune(r rune) bool {
+	return !strings.ContainsRune(threadsPostCodeAlpha, r)
+}
@@ -0,0 +1,108 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Viber Community: ^https://invite\.viber\.com/?\?g2=[A-Za-z0-9_+/=-]{4,128}$
+// Viber Group: ^https://invite\.viber\.com/?\?g=[A-Za-z0-9_+/=-]{4,128}$
+// Viber Channel: ^https://chats\.viber\.com/[A-Za-z0-9_.-]{1,64}/?$
+// Viber Channel: ^viber://pa\?chatURI=[A-Za-z0-9_.-]{1,64}$
+// Viber Account: ^viber://chat\?number=\+?[0-9]{7,15}$
+
+func decodeViberURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+
+	switch {
+	case url.Scheme == "viber" && url.Host == "chat":
+		phoneNumber, ok := parsePhoneNumber(rawQueryValue(url.RawQuery, "number"))
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid Viber phone number", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Viber,
+			Type:    "Account",
+			ID:      phoneNumber.String(),
+			Data:    phoneNumber.data(),
+			URL:     url,
+		}, nil
+
+	case url.Scheme == "viber" && url.Host == "pa":
+		return newViberChannelURL(url, rawQueryValue(url.RawQuery, "chatURI"))
+
+	case url.Scheme == "https" && url.Host == "chats.viber.com":
+		path := strings.TrimSuffix(url.Path, "/")
+		if len(path) < 1 || path[0] != '/' || strings.Count(path, "/") != 1 {
+			return nil, fmt.Errorf("%w: invalid Viber path", ErrInvalidURL)
+		}
+		return newViberChannelURL(url, strings.TrimPrefix(path, "/"))
+
+	case url.Scheme == "https" && url.Host == "invite.viber.com":
+		if url.Path != "" && url.Path != "/" {
+			return nil, fmt.Errorf("%w: invalid Viber path", ErrInvalidURL)
+		}
+
+		typ, code := "Community", rawQueryValue(url.RawQuery, "g2")
+		if code == "" {
+			typ, code = "Group", rawQueryValue(url.RawQuery, "g")
+		}
+		if len(code) < 4 || len(code) > 128 {
+			return nil, fmt.Errorf("%w: invalid Viber invite code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(code, isNotViberInviteCodeRune) {
+			return nil, fmt.Errorf("%w: invalid Viber invite code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Viber,
+			Type:    typ,
+			ID:      code,
+			Data: map[string]string{
+				"inviteCode": code,
+			},
+			URL: url,
+		}, nil
+
+	case url.Scheme == "https" || url.Scheme == "viber":
+		return nil, fmt.Errorf("%w: invalid Viber host", ErrInvalidURL)
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Viber scheme", ErrInvalidURL)
+	}
+}
+
+func newViberChannelURL(url *url.URL, name string) (*URL, error) {
+	if len(name) < 1 || len(name) > 64 {
+		return nil, fmt.Errorf("%w: invalid Viber channel length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(name, isNotViberHandleRune) {
+		return nil, fmt.Errorf("%w: invalid Viber channel", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: Viber,
+		Type:    "Channel",
+		ID:      name,
+		Data: map[string]string{
+			"channel": name,
+		},
+		URL: url,
+	}, nil
+}
+
+const viberHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_.-"
+
+func isNotViberHandleRune(r rune) bool {
+	return !strings.ContainsRune(viberHandleAlpha, r)
+}
+
+const viberInviteCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_+/=-"
+
+func isNotViberInviteCodeRune(r rune) bool {
+	return !strings.ContainsRune(viberInviteCodeAlpha, r)
+}
@@ -7,6 +7,13 @@ import (
 )
 
 // Vimeo Profile: ^https://(www\.)?vimeo\.com/[A-Za-z0-9_]{1,30}/?$
+// Vimeo Profile: ^https://(www\.)?vimeo\.com/user[0-9]+/?$
+// Vimeo Video: ^https://(www\.)?vimeo\.com/[0-9]{1,20}(/[0-9a-f]{1,20})?/?$
+// Vimeo Video: ^https://(www\.)?vimeo\.com/channels/[A-Za-z0-9_]{1,50}/[0-9]{1,20}/?$
+// Vimeo Video: ^https://player\.vimeo\.com/video/[0-9]{1,20}(\?h=[0-9a-f]{1,20})?$
+// Vimeo Channel: ^https://(www\.)?vimeo\.com/channels/[A-Za-z0-9_]{1,50}/?$
+// Vimeo Showcase: ^https://(www\.)?vimeo\.com/showcase/[0-9]{1,20}/?$
+// Vimeo Group: ^https://(www\.)?vimeo\.com/groups/[A-Za-z0-9_]{1,50}/?$
 
 func decodeVimeoURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,7 +23,7 @@ func decodeVimeoURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid Vimeo scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "vimeo.com" && url.Host != "www.vimeo.com" {
+	if url.Host != "vimeo.com" && url.Host != "www.vimeo.com" && url.Host != "player.vimeo.com" {
 		return nil, fmt.Errorf("%w: invalid Vimeo host", ErrInvalidURL)
 	}
 
@@ -24,28 +31,154 @@ func decodeVimeoURL(url *url.URL) (*URL, error) {
 	if len(path) < 1 || path[0] != '/' {
 		return nil, fmt.Errorf("%w: invalid Vimeo path", ErrInvalidURL)
 	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
 
-	username := strings.TrimPrefix(path, "/")
-	if len(username) < 1 || len(username) > 30 {
-		return nil, fmt.Errorf("%w: invalid Vimeo username length", ErrInvalidURL)
+	if url.Host == "player.vimeo.com" {
+		if len(parts) != 2 || parts[0] != "video" {
+			return nil, fmt.Errorf("%w: invalid Vimeo path", ErrInvalidURL)
+		}
+		return newVimeoVideoURL(url, parts[1], url.Query().Get("h"), "")
 	}
-	if strings.ContainsFunc(username, isNotVimeoHandleRune) {
-		return nil, fmt.Errorf("%w: invalid Vimeo username", ErrInvalidURL)
+
+	switch {
+	case len(parts) == 3 && parts[0] == "channels":
+		if !isVimeoNameValid(parts[1]) {
+			return nil, fmt.Errorf("%w: invalid Vimeo channel", ErrInvalidURL)
+		}
+		return newVimeoVideoURL(url, parts[2], "", parts[1])
+
+	case len(parts) == 2 && parts[0] == "channels":
+		channel := parts[1]
+		if !isVimeoNameValid(channel) {
+			return nil, fmt.Errorf("%w: invalid Vimeo channel", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Vimeo,
+			Type:    "Channel",
+			ID:      channel,
+			Data: map[string]string{
+				"channel": channel,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "showcase":
+		showcaseID := parts[1]
+		if !isVimeoIDValid(showcaseID) {
+			return nil, fmt.Errorf("%w: invalid Vimeo showcase ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Vimeo,
+			Type:    "Showcase",
+			ID:      showcaseID,
+			Data: map[string]string{
+				"showcaseID": showcaseID,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && parts[0] == "groups":
+		group := parts[1]
+		if !isVimeoNameValid(group) {
+			return nil, fmt.Errorf("%w: invalid Vimeo group", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: Vimeo,
+			Type:    "Group",
+			ID:      group,
+			Data: map[string]string{
+				"group": group,
+			},
+			URL: url,
+		}, nil
+
+	case len(parts) == 2 && isVimeoIDValid(parts[0]):
+		return newVimeoVideoURL(url, parts[0], parts[1], "")
+
+	case len(parts) == 1 && isVimeoIDValid(parts[0]):
+		return newVimeoVideoURL(url, parts[0], "", "")
+
+	case len(parts) == 1:
+		username := parts[0]
+		if len(username) < 1 || len(username) > 30 {
+			return nil, fmt.Errorf("%w: invalid Vimeo username length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(username, isNotVimeoHandleRune) {
+			return nil, fmt.Errorf("%w: invalid Vimeo username", ErrInvalidURL)
+		}
+
+		data := map[string]string{
+			"username": username,
+		}
+		if userID := strings.TrimPrefix(username, "user"); userID != username && isVimeoIDValid(userID) {
+			data["userID"] = userID
+		}
+
+		return &URL{
+			Service: Vimeo,
+			Type:    "Profile",
+			ID:      username,
+			Data:    data,
+			URL:     url,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: invalid Vimeo path", ErrInvalidURL)
+	}
+}
+
+func newVimeoVideoURL(url *url.URL, videoID, hash, channel string) (*URL, error) {
+	if !isVimeoIDValid(videoID) {
+		return nil, fmt.Errorf("%w: invalid Vimeo video ID", ErrInvalidURL)
+	}
+	if hash != "" && (len(hash) > 20 || strings.ContainsFunc(hash, isNotVimeoHashRune)) {
+		return nil, fmt.Errorf("%w: invalid Vimeo video hash", ErrInvalidURL)
+	}
+
+	data := map[string]string{
+		"videoID": videoID,
+	}
+	if hash != "" {
+		data["hash"] = hash // Unlisted videos can only be viewed with this hash.
+	}
+	if channel != "" {
+		data["channel"] = channel
 	}
 
 	return &URL{
 		Service: Vimeo,
-		Type:    "Profile",
-		ID:      username,
-		Data: map[string]string{
-			"username": username,
-		},
-		URL: url,
+		Type:    "Video",
+		ID:      videoID,
+		Data:    data,
+		URL:     url,
 	}, nil
 }
 
+func isVimeoIDValid(id string) bool {
+	return len(id) >= 1 && len(id) <= 20 && !strings.ContainsFunc(id, isNotVimeoIDRune)
+}
+
+func isVimeoNameValid(name string) bool {
+	return len(name) >= 1 && len(name) <= 50 && !strings.ContainsFunc(name, isNotVimeoHandleRune)
+}
+
 const vimeoHandleAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
 
 func isNotVimeoHandleRune(r rune) bool {
 	return !strings.ContainsRune(vimeoHandleAlpha, r)
 }
+
+const vimeoIDAlpha = "0123456789"
+
+func isNotVimeoIDRune(r rune) bool {
+	return !strings.ContainsRune(vimeoIDAlpha, r)
+}
+
+const vimeoHashAlpha = "0123456789abcdef"
+
+func isNotVimeoHashRune(r rune) bool {
+	return !strings.ContainsRune(vimeoHashAlpha, r)
+}
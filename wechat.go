@@ -0,0 +1,66 @@
+package slinky
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WeChat Profile: ^https://u\.wechat\.com/[A-Za-z0-9_-]{4,64}/?$
+// WeChat Profile: ^https://weixin\.qq\.com/r/[A-Za-z0-9_-]{4,64}/?$
+// WeChat Group: ^https://weixin\.qq\.com/g/[A-Za-z0-9_-]{4,64}/?$
+//
+// These are the links encoded in WeChat QR codes, which identify a contact,
+// official account or group chat by an opaque code.
+
+func decodeWeChatURL(url *url.URL) (*URL, error) {
+	if url.Scheme == "http" {
+		url.Scheme = "https"
+	}
+	if url.Scheme != "https" {
+		return nil, fmt.Errorf("%w: invalid WeChat scheme", ErrInvalidURL)
+	}
+
+	path := strings.TrimSuffix(url.Path, "/")
+	if len(path) < 1 || path[0] != '/' {
+		return nil, fmt.Errorf("%w: invalid WeChat path", ErrInvalidURL)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var typ, code string
+	switch {
+	case url.Host == "u.wechat.com" && len(parts) == 1:
+		typ, code = "Profile", parts[0]
+	case url.Host == "weixin.qq.com" && len(parts) == 2 && parts[0] == "r":
+		typ, code = "Profile", parts[1]
+	case url.Host == "weixin.qq.com" && len(parts) == 2 && parts[0] == "g":
+		typ, code = "Group", parts[1]
+	case url.Host == "u.wechat.com" || url.Host == "weixin.qq.com":
+		return nil, fmt.Errorf("%w: invalid WeChat path", ErrInvalidURL)
+	default:
+		return nil, fmt.Errorf("%w: invalid WeChat host", ErrInvalidURL)
+	}
+
+	if len(code) < 4 || len(code) > 64 {
+		return nil, fmt.Errorf("%w: invalid WeChat code length", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(code, isNotWeChatCodeRune) {
+		return nil, fmt.Errorf("%w: invalid WeChat code", ErrInvalidURL)
+	}
+
+	return &URL{
+		Service: WeChat,
+		Type:    typ,
+		ID:      code,
+		Data: map[string]string{
+			"code": code,
+		},
+		URL: url,
+	}, nil
+}
+
+const wechatCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+
+func isNotWeChatCodeRune(r rune) bool {
+	return !strings.ContainsRune(wechatCodeAlpha, r)
+}
@@ -6,7 +6,10 @@ import (
 	"strings"
 )
 
-// WhatsApp Account: ^https://wa\.me/\+?[0-9]{7,15}/?$
+// WhatsApp Account: ^https://wa\.me/\+?[0-9]{7,15}/?(\?text=.*)?$
+// WhatsApp Account: ^https://api\.whatsapp\.com/send/?\?phone=\+?[0-9]{7,15}(&text=.*)?$
+// WhatsApp Group: ^https://chat\.whatsapp\.com/[A-Za-z0-9]{16,32}/?$
+// WhatsApp Channel: ^https://(www\.)?whatsapp\.com/channel/[A-Za-z0-9]{16,32}/?$
 
 func decodeWhatsAppURL(url *url.URL) (*URL, error) {
 	if url.Scheme == "http" {
@@ -16,36 +19,109 @@ func decodeWhatsAppURL(url *url.URL) (*URL, error) {
 		return nil, fmt.Errorf("%w: invalid WhatsApp scheme", ErrInvalidURL)
 	}
 
-	if url.Host != "wa.me" && url.Host != "www.wa.me" {
+	path := strings.TrimSuffix(url.Path, "/")
+	switch url.Host {
+	case "wa.me", "www.wa.me":
+		if len(path) < 1 || path[0] != '/' {
+			return nil, fmt.Errorf("%w: invalid WhatsApp path", ErrInvalidURL)
+		}
+		return newWhatsAppAccountURL(url, strings.TrimPrefix(path, "/"))
+
+	case "api.whatsapp.com":
+		if path != "/send" {
+			return nil, fmt.Errorf("%w: invalid WhatsApp path", ErrInvalidURL)
+		}
+		return newWhatsAppAccountURL(url, url.Query().Get("phone"))
+
+	case "chat.whatsapp.com":
+		if len(path) < 1 || path[0] != '/' {
+			return nil, fmt.Errorf("%w: invalid WhatsApp path", ErrInvalidURL)
+		}
+
+		inviteCode := strings.TrimPrefix(path, "/")
+		if len(inviteCode) < 16 || len(inviteCode) > 32 {
+			return nil, fmt.Errorf("%w: invalid WhatsApp invite code length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(inviteCode, isNotWhatsAppCodeRune) {
+			return nil, fmt.Errorf("%w: invalid WhatsApp invite code", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: WhatsApp,
+			Type:    "Group",
+			ID:      inviteCode,
+			Data: map[string]string{
+				"inviteCode": inviteCode,
+			},
+			URL: url,
+		}, nil
+
+	case "whatsapp.com", "www.whatsapp.com":
+		if !strings.HasPrefix(path, "/channel/") {
+			return nil, fmt.Errorf("%w: invalid WhatsApp path", ErrInvalidURL)
+		}
+
+		channelID := strings.TrimPrefix(path, "/channel/")
+		if len(channelID) < 16 || len(channelID) > 32 {
+			return nil, fmt.Errorf("%w: invalid WhatsApp channel ID length", ErrInvalidURL)
+		}
+		if strings.ContainsFunc(channelID, isNotWhatsAppCodeRune) {
+			return nil, fmt.Errorf("%w: invalid WhatsApp channel ID", ErrInvalidURL)
+		}
+
+		return &URL{
+			Service: WhatsApp,
+			Type:    "Channel",
+			ID:      channelID,
+			Data: map[string]string{
+				"channelID": channelID,
+			},
+			URL: url,
+		}, nil
+
+	default:
 		return nil, fmt.Errorf("%w: invalid WhatsApp host", ErrInvalidURL)
 	}
+}
 
-	path := strings.TrimSuffix(url.Path, "/")
-	if len(path) < 1 || path[0] != '/' {
-		return nil, fmt.Errorf("%w: invalid WhatsApp path", ErrInvalidURL)
+func newWhatsAppAccountURL(url *url.URL, s string) (*URL, error) {
+	phoneNumber, ok := parsePhoneNumber(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid WhatsApp phone number", ErrInvalidURL)
 	}
 
-	phoneNumber := strings.TrimPrefix(path, "/")
-	if len(phoneNumber) < 7 || len(phoneNumber) > 16 {
-		return nil, fmt.Errorf("%w: invalid WhatsApp phone number length", ErrInvalidURL)
-	}
-	if strings.ContainsFunc(phoneNumber, isNotWhatsAppPhoneNumberRune) {
-		return nil, fmt.Errorf("%w: invalid WhatsApp phone number", ErrInvalidURL)
+	data := phoneNumber.data()
+	if text := rawQueryValue(url.RawQuery, "text"); text != "" {
+		data["text"] = text
 	}
 
 	return &URL{
 		Service: WhatsApp,
 		Type:    "Account",
-		ID:      phoneNumber,
-		Data: map[string]string{
-			"phoneNumber": phoneNumber,
-		},
-		URL: url,
+		ID:      phoneNumber.String(),
+		Data:    data,
+		URL:     url,
 	}, nil
 }
 
-const whatsAppPhoneNumberAlpha = "0123456789+"
+// WhatsAppChatURL returns a wa.me link that opens a chat with phoneNumber. If
+// text is not empty, it is prefilled in the message box.
+func WhatsAppChatURL(phoneNumber, text string) (string, error) {
+	p, ok := parsePhoneNumber(phoneNumber)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid WhatsApp phone number", ErrInvalidURL)
+	}
+
+	link := "https://wa.me/" + p.countryCode + p.nationalNumber
+	if text != "" {
+		// WhatsApp does not decode "+" as a space in the message text.
+		link += "?text=" + strings.ReplaceAll(url.QueryEscape(text), "+", "%20")
+	}
+	return link, nil
+}
+
+const whatsAppCodeAlpha = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
 
-func isNotWhatsAppPhoneNumberRune(r rune) bool {
-	return !strings.ContainsRune(whatsAppPhoneNumberAlpha, r)
+func isNotWhatsAppCodeRune(r rune) bool {
+	return !strings.ContainsRune(whatsAppCodeAlpha, r)
 }